@@ -0,0 +1,315 @@
+package zgen
+
+import (
+  "github.com/znxlc/zerror"
+  "math"
+  "math/big"
+  "reflect"
+  "strings"
+  "time"
+
+  "github.com/shopspring/decimal"
+)
+
+// BigInt - tries to convert any to *big.Int, fractional values are truncated unless a rounding mode is set
+func BigInt(src any, opts ...ConvertOptions) (dst *big.Int, err zerror.Error) {
+  if src == nil {
+    return new(big.Int), nil
+  }
+  opt := getConvertOptions(opts)
+  if res, found, zer := convertRegistered[*big.Int](src, opt.Converters); found { // custom registered converter
+    if zer != nil {
+      return new(big.Int), zer
+    }
+    return res, nil
+  }
+  src = jsonNumberValue(src)
+  src, err = applyNumberFormat(src, opt.NumberFormat, "*big.Int")
+  if err != nil {
+    return new(big.Int), err
+  }
+  ratVal, err := bigRatValue(src, "*big.Int", opt)
+  if err != nil {
+    return new(big.Int), err
+  }
+  if ratVal.IsInt() {
+    return new(big.Int).Set(ratVal.Num()), nil
+  }
+  if opt.Strict {
+    return new(big.Int), strictPrecisionLossError(src, "*big.Int")
+  }
+  return roundRat(ratVal, opt.Rounding), nil
+}
+
+// BigFloat - tries to convert any to *big.Float
+// the precision is kept for *big.Float sources, the other sources use the precision needed by their value (at least 64 bits)
+func BigFloat(src any, opts ...ConvertOptions) (dst *big.Float, err zerror.Error) {
+  if src == nil {
+    return new(big.Float), nil
+  }
+  opt := getConvertOptions(opts)
+  if res, found, zer := convertRegistered[*big.Float](src, opt.Converters); found { // custom registered converter
+    if zer != nil {
+      return new(big.Float), zer
+    }
+    return res, nil
+  }
+  src = jsonNumberValue(src)
+  src, err = applyNumberFormat(src, opt.NumberFormat, "*big.Float")
+  if err != nil {
+    return new(big.Float), err
+  }
+  if val, ok := src.(*big.Float); ok && val != nil { // infinite values can not be represented as *big.Rat
+    return new(big.Float).Set(val), nil
+  }
+  ratVal, err := bigRatValue(src, "*big.Float", opt)
+  if err != nil {
+    return new(big.Float), err
+  }
+  dst = new(big.Float).SetRat(ratVal)
+  if opt.Strict && dst.Acc() != big.Exact {
+    return new(big.Float), strictPrecisionLossError(src, "*big.Float")
+  }
+  return dst, nil
+}
+
+// BigRat - tries to convert any to *big.Rat, the conversion is exact for every supported source
+func BigRat(src any, opts ...ConvertOptions) (dst *big.Rat, err zerror.Error) {
+  if src == nil {
+    return new(big.Rat), nil
+  }
+  opt := getConvertOptions(opts)
+  if res, found, zer := convertRegistered[*big.Rat](src, opt.Converters); found { // custom registered converter
+    if zer != nil {
+      return new(big.Rat), zer
+    }
+    return res, nil
+  }
+  src = jsonNumberValue(src)
+  src, err = applyNumberFormat(src, opt.NumberFormat, "*big.Rat")
+  if err != nil {
+    return new(big.Rat), err
+  }
+  return bigRatValue(src, "*big.Rat", opt)
+}
+
+// bigRatValue - converts the numeric sources, numeric strings and []byte to an exact *big.Rat
+// NaN and infinite values return ErrorConvertorNumberOverflow
+func bigRatValue(src any, dstType string, opt ConvertOptions) (dst *big.Rat, err zerror.Error) {
+  dst = new(big.Rat)
+  switch val := src.(type) {
+  case *big.Rat:
+    if val != nil {
+      dst.Set(val)
+    }
+  case *big.Int:
+    if val != nil {
+      dst.SetInt(val)
+    }
+  case *big.Float:
+    if val == nil {
+      return dst, nil
+    }
+    if val.IsInf() {
+      return new(big.Rat), bigOverflowError(src, dstType)
+    }
+    val.Rat(dst)
+  case decimal.Decimal:
+    dst.Set(val.Rat())
+  case int, int8, int16, int32, int64, time.Duration:
+    dst.SetInt64(reflect.ValueOf(val).Int())
+  case uint, uint8, uint16, uint32, uint64:
+    dst.SetInt(new(big.Int).SetUint64(reflect.ValueOf(val).Uint()))
+  case float32:
+    return bigRatValue(float64(val), dstType, opt)
+  case float64:
+    if math.IsNaN(val) || math.IsInf(val, 0) {
+      return new(big.Rat), bigOverflowError(src, dstType)
+    }
+    dst.SetFloat64(val)
+  case complex64:
+    return bigRatValue(complex128(val), dstType, opt)
+  case complex128:
+    if opt.Strict && imag(val) != 0 {
+      return new(big.Rat), strictPrecisionLossError(src, dstType)
+    }
+    return bigRatValue(real(val), dstType, opt)
+  case bool:
+    if val {
+      dst.SetInt64(1)
+    }
+  case time.Time: // the unix value
+    if opt.Strict && val.Nanosecond() != 0 {
+      return new(big.Rat), strictPrecisionLossError(src, dstType)
+    }
+    dst.SetInt64(val.Unix())
+  case []byte:
+    return bigRatValue(string(val), dstType, opt)
+  case string:
+    number := strings.TrimSpace(val)
+    if literal, base, ok := integerLiteral(number); ok {
+      if intVal, ok := new(big.Int).SetString(literal, base); ok {
+        return dst.SetInt(intVal), nil
+      }
+    }
+    if _, ok := dst.SetString(number); !ok { // decimals, exponents and fractions ("1.5", "2e40", "1/3")
+      return new(big.Rat), zerror.New(ErrorConvertorTypeNotSupported, map[string]any{
+        "src":      val,
+        "src_type": "string",
+        "dst_type": dstType,
+      })
+    }
+  default:
+    return new(big.Rat), zerror.New(ErrorConvertorTypeNotSupported, map[string]any{
+      "src":      val,
+      "src_type": reflect.TypeOf(val).String(),
+      "dst_type": dstType,
+    })
+  }
+  return dst, nil
+}
+
+// bigOverflowError - returns the overflow error for NaN and infinite values
+func bigOverflowError(src any, dstType string) zerror.Error {
+  return zerror.New(ErrorConvertorNumberOverflow, map[string]any{
+    "from_type": reflect.TypeOf(src).String(),
+    "to_type":   dstType,
+    "value":     src,
+  })
+}
+
+// roundRat - rounds the fraction to an integer using the rounding mode
+func roundRat(val *big.Rat, mode RoundingMode) *big.Int {
+  quo, rem := new(big.Int).QuoRem(val.Num(), val.Denom(), new(big.Int)) // truncated toward zero
+  if rem.Sign() == 0 {
+    return quo
+  }
+  away := false // round away from zero
+  switch mode {
+  case RoundFloor:
+    away = val.Sign() < 0
+  case RoundCeil:
+    away = val.Sign() > 0
+  case RoundHalfUp, RoundHalfEven:
+    half := new(big.Int).Abs(rem)
+    half.Lsh(half, 1).Sub(half, val.Denom()) // 2*|rem| - denominator, compares the remainder with one half
+    away = half.Sign() > 0 || (half.Sign() == 0 && (mode == RoundHalfUp || quo.Bit(0) == 1))
+  }
+  if away {
+    quo.Add(quo, big.NewInt(int64(val.Sign())))
+  }
+  return quo
+}
+
+// ratDecimal - converts the fraction to a decimal, exact is false when the decimal expansion does not terminate
+// and the value is rounded to decimal.DivisionPrecision decimal places
+func ratDecimal(val *big.Rat) (dst decimal.Decimal, exact bool) {
+  denom := new(big.Int).Set(val.Denom())
+  twos, fives := 0, 0
+  five, mod := big.NewInt(5), new(big.Int)
+  for denom.Bit(0) == 0 {
+    denom.Rsh(denom, 1)
+    twos++
+  }
+  for {
+    quo, _ := new(big.Int).QuoRem(denom, five, mod)
+    if mod.Sign() != 0 {
+      break
+    }
+    denom = quo
+    fives++
+  }
+  num, den := decimal.NewFromBigInt(val.Num(), 0), decimal.NewFromBigInt(val.Denom(), 0)
+  if denom.Cmp(big.NewInt(1)) != 0 {
+    return num.Div(den), false
+  }
+  places := twos
+  if fives > places {
+    places = fives
+  }
+  scaled := new(big.Int).Mul(val.Num(), new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(places)), nil))
+  return decimal.NewFromBigInt(scaled.Quo(scaled, val.Denom()), -int32(places)), true
+}
+
+// bigDecimal - converts the big number to a decimal, infinite values return ErrorConvertorNumberOverflow
+// and non terminating fractions ErrorConvertorPrecisionLoss in strict mode
+func bigDecimal(src any, dstType string, strict bool) (dst decimal.Decimal, err zerror.Error) {
+  ratVal, err := bigRatValue(src, dstType, ConvertOptions{})
+  if err != nil {
+    return decimal.NewFromInt(0), err
+  }
+  dst, exact := ratDecimal(ratVal)
+  if strict && !exact {
+    return decimal.NewFromInt(0), strictPrecisionLossError(src, dstType)
+  }
+  return dst, nil
+}
+
+// bigFloatValue - converts the big number to a float with bitSize precision (32 or 64)
+// values beyond the float range return ErrorConvertorNumberOverflow, inexact values ErrorConvertorPrecisionLoss in strict mode
+func bigFloatValue(src any, dstType string, bitSize int, strict bool) (dst float64, err zerror.Error) {
+  var floatVal *big.Float
+  switch val := src.(type) {
+  case *big.Rat: // converted directly, a *big.Float step would round twice
+    if val == nil {
+      return 0, nil
+    }
+    exact := false
+    if bitSize == 32 {
+      var f float32
+      f, exact = val.Float32()
+      dst = float64(f)
+    } else {
+      dst, exact = val.Float64()
+    }
+    if math.IsInf(dst, 0) {
+      return 0, bigOverflowError(src, dstType)
+    }
+    if strict && !exact {
+      return 0, strictPrecisionLossError(src, dstType)
+    }
+    return dst, nil
+  case *big.Int:
+    if val == nil {
+      return 0, nil
+    }
+    floatVal = new(big.Float).SetInt(val)
+  case *big.Float:
+    if val == nil {
+      return 0, nil
+    }
+    floatVal = val
+  }
+
+  var acc big.Accuracy
+  if bitSize == 32 {
+    var f float32
+    f, acc = floatVal.Float32()
+    dst = float64(f)
+  } else {
+    dst, acc = floatVal.Float64()
+  }
+  if math.IsInf(dst, 0) && !floatVal.IsInf() {
+    return 0, bigOverflowError(src, dstType)
+  }
+  if strict && acc != big.Exact {
+    return 0, strictPrecisionLossError(src, dstType)
+  }
+  return dst, nil
+}
+
+// integerBigValue - the integer converter cores do not handle big numbers, converts them to decimals
+// infinite *big.Float values are returned as float64 so the range checks report the overflow
+func integerBigValue(src any) (dst any, ok bool) {
+  switch val := src.(type) {
+  case *big.Float:
+    if val != nil && val.IsInf() {
+      return math.Inf(val.Sign()), true
+    }
+  case *big.Int, *big.Rat:
+  default:
+    return src, false
+  }
+  dst, _ = bigDecimal(src, "", false)
+  return dst, true
+}
@@ -0,0 +1,232 @@
+package zgen
+
+import (
+  "math"
+  "math/big"
+  "testing"
+
+  "github.com/shopspring/decimal"
+  "github.com/stretchr/testify/assert"
+)
+
+func TestUnit_BigInt(t *testing.T) {
+  huge, _ := new(big.Int).SetString("340282366920938463463374607431768211455", 10) // max uint128
+
+  tests := []struct {
+    name      string
+    input     any
+    opts      []ConvertOptions
+    expect    string
+    errorCode string
+  }{
+    {name: "int", input: -42, expect: "-42"},
+    {name: "uint64", input: uint64(math.MaxUint64), expect: "18446744073709551615"},
+    {name: "float truncated", input: 2.7, expect: "2"},
+    {name: "float rounded", input: -2.5, opts: []ConvertOptions{{Rounding: RoundHalfUp}}, expect: "-3"},
+    {name: "half even", input: "2.5", opts: []ConvertOptions{{Rounding: RoundHalfEven}}, expect: "2"},
+    {name: "floor", input: "-2.1", opts: []ConvertOptions{{Rounding: RoundFloor}}, expect: "-3"},
+    {name: "large string", input: "340282366920938463463374607431768211455", expect: huge.String()},
+    {name: "hex literal", input: "0xffffffffffffffffffffffffffffffff", expect: huge.String()},
+    {name: "underscores", input: "1_000_000_000_000_000_000_000", expect: "1000000000000000000000"},
+    {name: "exponent", input: "1e30", expect: "1000000000000000000000000000000"},
+    {name: "bytes", input: []byte("12345678901234567890"), expect: "12345678901234567890"},
+    {name: "decimal", input: decimal.RequireFromString("123456789012345678901234.9"), expect: "123456789012345678901234"},
+    {name: "big int", input: huge, expect: huge.String()},
+    {name: "big rat", input: big.NewRat(7, 2), expect: "3"},
+    {name: "big float", input: big.NewFloat(1e20), expect: "100000000000000000000"},
+    {name: "number format", input: "1.234.567", opts: []ConvertOptions{{NumberFormat: &NumberFormatEU}}, expect: "1234567"},
+    {name: "strict fraction", input: "2.5", opts: []ConvertOptions{{Strict: true}}, errorCode: ErrorConvertorPrecisionLoss},
+    {name: "NaN", input: math.NaN(), errorCode: ErrorConvertorNumberOverflow},
+    {name: "infinite big float", input: new(big.Float).SetInf(false), errorCode: ErrorConvertorNumberOverflow},
+    {name: "invalid string", input: "banana", errorCode: ErrorConvertorTypeNotSupported},
+    {name: "unsupported type", input: struct{}{}, errorCode: ErrorConvertorTypeNotSupported},
+  }
+
+  for _, tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      res, err := BigInt(tt.input, tt.opts...)
+      if tt.errorCode != "" {
+        if assert.NotNil(t, err) {
+          assert.True(t, err.Has(tt.errorCode), "Expected error code '%s' but got '%s'", tt.errorCode, err.Error())
+        }
+        return
+      }
+      assert.Nil(t, err)
+      assert.Equal(t, tt.expect, res.String())
+    })
+  }
+
+  t.Run("result does not share the source", func(t *testing.T) {
+    src := big.NewInt(5)
+    res, err := BigInt(src)
+    assert.Nil(t, err)
+    res.SetInt64(6)
+    assert.Equal(t, int64(5), src.Int64())
+  })
+}
+
+func TestUnit_BigFloat(t *testing.T) {
+  tests := []struct {
+    name      string
+    input     any
+    opts      []ConvertOptions
+    expect    string
+    errorCode string
+  }{
+    {name: "int", input: 42, expect: "42"},
+    {name: "float", input: 0.1, expect: "0.10000000000000000555"},
+    {name: "large string", input: "123456789012345678901234567890.5", expect: "123456789012345678901234567890.5"},
+    {name: "decimal", input: decimal.RequireFromString("-1.25"), expect: "-1.25"},
+    {name: "big rat", input: big.NewRat(1, 4), expect: "0.25"},
+    {name: "infinite big float", input: new(big.Float).SetInf(true), expect: "-Inf"},
+    {name: "strict inexact", input: "0.1", opts: []ConvertOptions{{Strict: true}}, errorCode: ErrorConvertorPrecisionLoss},
+    {name: "strict exact", input: "0.5", opts: []ConvertOptions{{Strict: true}}, expect: "0.5"},
+    {name: "+Inf", input: math.Inf(1), errorCode: ErrorConvertorNumberOverflow},
+    {name: "invalid string", input: "1.2.3", errorCode: ErrorConvertorTypeNotSupported},
+  }
+
+  for _, tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      res, err := BigFloat(tt.input, tt.opts...)
+      if tt.errorCode != "" {
+        if assert.NotNil(t, err) {
+          assert.True(t, err.Has(tt.errorCode), "Expected error code '%s' but got '%s'", tt.errorCode, err.Error())
+        }
+        return
+      }
+      assert.Nil(t, err)
+      assert.Equal(t, tt.expect, res.Text('f', -1))
+    })
+  }
+
+  t.Run("keeps the source precision", func(t *testing.T) {
+    res, err := BigFloat(new(big.Float).SetPrec(200).SetInt64(1))
+    assert.Nil(t, err)
+    assert.Equal(t, uint(200), res.Prec())
+  })
+}
+
+func TestUnit_BigRat(t *testing.T) {
+  tests := []struct {
+    name      string
+    input     any
+    expect    string
+    errorCode string
+  }{
+    {name: "int", input: int8(-3), expect: "-3"},
+    {name: "float is exact", input: 0.5, expect: "1/2"},
+    {name: "fraction string", input: "1/3", expect: "1/3"},
+    {name: "decimal string", input: "0.125", expect: "1/8"},
+    {name: "decimal", input: decimal.RequireFromString("2.5"), expect: "5/2"},
+    {name: "big int", input: big.NewInt(7), expect: "7"},
+    {name: "bool", input: true, expect: "1"},
+    {name: "NaN", input: math.NaN(), errorCode: ErrorConvertorNumberOverflow},
+    {name: "invalid string", input: "one third", errorCode: ErrorConvertorTypeNotSupported},
+  }
+
+  for _, tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      res, err := BigRat(tt.input)
+      if tt.errorCode != "" {
+        if assert.NotNil(t, err) {
+          assert.True(t, err.Has(tt.errorCode), "Expected error code '%s' but got '%s'", tt.errorCode, err.Error())
+        }
+        return
+      }
+      assert.Nil(t, err)
+      assert.Equal(t, tt.expect, res.RatString())
+    })
+  }
+}
+
+func TestUnit_BigSources(t *testing.T) {
+  huge, _ := new(big.Int).SetString("100000000000000000000", 10)
+
+  tests := []struct {
+    name      string
+    convert   func() (any, error)
+    expect    any
+    errorCode string
+  }{
+    // integer destinations
+    {name: "int64 from big int", convert: func() (any, error) { return Int64(big.NewInt(-42)) }, expect: int64(-42)},
+    {name: "int64 overflow", convert: func() (any, error) { return Int64(huge) }, errorCode: ErrorConvertorNumberOverflow},
+    {name: "uint8 overflow", convert: func() (any, error) { return Uint8(big.NewInt(256)) }, errorCode: ErrorConvertorNumberOverflow},
+    {name: "uint64 from negative", convert: func() (any, error) { return Uint64(big.NewInt(-1)) }, errorCode: ErrorConvertorNumberOverflow},
+    {name: "int saturated", convert: func() (any, error) { return Int8(huge, ConvertOptions{Overflow: OverflowSaturate}) }, expect: int8(math.MaxInt8)},
+    {name: "uint64 max", convert: func() (any, error) { return Uint64(new(big.Int).SetUint64(math.MaxUint64)) }, expect: uint64(math.MaxUint64)},
+    {name: "int from big rat", convert: func() (any, error) { return Int(big.NewRat(7, 2)) }, expect: 3},
+    {name: "int from big rat rounded", convert: func() (any, error) { return Int(big.NewRat(7, 2), ConvertOptions{Rounding: RoundHalfUp}) }, expect: 4},
+    {name: "int strict fraction", convert: func() (any, error) { return Int(big.NewRat(1, 3), ConvertOptions{Strict: true}) }, errorCode: ErrorConvertorPrecisionLoss},
+    {name: "int32 from big float", convert: func() (any, error) { return Int32(big.NewFloat(-12.9)) }, expect: int32(-12)},
+    {name: "int from infinite big float", convert: func() (any, error) { return Int(new(big.Float).SetInf(false)) }, errorCode: ErrorConvertorNumberOverflow},
+
+    // float destinations
+    {name: "float64 from big int", convert: func() (any, error) { return Float64(huge) }, expect: 1e20},
+    {name: "float64 from big rat", convert: func() (any, error) { return Float64(big.NewRat(1, 4)) }, expect: 0.25},
+    {name: "float64 overflow", convert: func() (any, error) { return Float64(new(big.Int).Lsh(big.NewInt(1), 1100)) }, errorCode: ErrorConvertorNumberOverflow},
+    {name: "float64 strict inexact", convert: func() (any, error) { return Float64(big.NewRat(1, 3), ConvertOptions{Strict: true}) }, errorCode: ErrorConvertorPrecisionLoss},
+    {name: "float32 from big float", convert: func() (any, error) { return Float32(big.NewFloat(0.5)) }, expect: float32(0.5)},
+    {name: "float32 overflow", convert: func() (any, error) { return Float32(big.NewFloat(1e300)) }, errorCode: ErrorConvertorNumberOverflow},
+
+    // decimal destination
+    {name: "decimal from big int", convert: func() (any, error) { return Decimal(huge) }, expect: decimal.RequireFromString("100000000000000000000")},
+    {name: "decimal from big float", convert: func() (any, error) { return Decimal(big.NewFloat(0.375)) }, expect: decimal.RequireFromString("0.375")},
+    {name: "decimal from big rat", convert: func() (any, error) { return Decimal(big.NewRat(1, 8)) }, expect: decimal.RequireFromString("0.125")},
+    {name: "decimal strict repeating fraction", convert: func() (any, error) { return Decimal(big.NewRat(1, 3), ConvertOptions{Strict: true}) }, errorCode: ErrorConvertorPrecisionLoss},
+
+    // string destination
+    {name: "string from big int", convert: func() (any, error) { return String(huge) }, expect: "100000000000000000000"},
+    {name: "string from big float", convert: func() (any, error) { return String(big.NewFloat(1.5)) }, expect: "1.5"},
+    {name: "string from big rat", convert: func() (any, error) { return String(big.NewRat(6, 3)) }, expect: "2"},
+
+    // generic
+    {name: "generic big int", convert: func() (any, error) { return To[*big.Int]("12") }, expect: big.NewInt(12)},
+  }
+
+  for _, tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      res, err := tt.convert()
+      if tt.errorCode != "" {
+        if assert.NotNil(t, err) {
+          assert.True(t, err.(interface{ Has(string) bool }).Has(tt.errorCode), "Expected error code '%s' but got '%s'", tt.errorCode, err.Error())
+        }
+        return
+      }
+      assert.Nil(t, err)
+      if expectDecimal, ok := tt.expect.(decimal.Decimal); ok {
+        assert.True(t, expectDecimal.Equal(res.(decimal.Decimal)), "Expected %s but got %s", expectDecimal, res)
+        return
+      }
+      assert.Equal(t, tt.expect, res)
+    })
+  }
+}
+
+func TestUnit_BigToStruct(t *testing.T) {
+  dst := struct {
+    Balance  big.Int    `json:"balance"`
+    Supply   *big.Int   `json:"supply"`
+    Price    *big.Float `json:"price"`
+    Fraction *big.Rat   `json:"fraction"`
+  }{}
+
+  err := ToStruct(&dst, DefaultParserConfig, map[string]any{
+    "balance":  "340282366920938463463374607431768211455",
+    "supply":   uint64(math.MaxUint64),
+    "price":    "0.5",
+    "fraction": "3/4",
+  })
+  assert.Nil(t, err)
+  assert.Equal(t, "340282366920938463463374607431768211455", dst.Balance.String())
+  assert.Equal(t, "18446744073709551615", dst.Supply.String())
+  assert.Equal(t, "0.5", dst.Price.Text('f', -1))
+  assert.Equal(t, "3/4", dst.Fraction.RatString())
+
+  config := DefaultParserConfig
+  config.Strict = true
+  err = ToStruct(&dst, config, map[string]any{"supply": 1.5})
+  if assert.NotNil(t, err) {
+    assert.True(t, err.Has(ErrorConvertorPrecisionLoss))
+  }
+}
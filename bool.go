@@ -0,0 +1,83 @@
+package zgen
+
+import (
+  "errors"
+  "github.com/znxlc/zerror"
+  "math"
+  "strconv"
+  "strings"
+)
+
+// BoolWords - vocabulary used by Bool for string and []byte sources, the words are matched case-insensitively after trimming the spaces
+type BoolWords struct {
+  True  []string `json:"true"`  // words converted to true
+  False []string `json:"false"` // words converted to false
+}
+
+var (
+  // DefaultBoolWords - vocabulary used by Bool when ConvertOptions.BoolWords is nil
+  DefaultBoolWords = BoolWords{
+    True:  []string{"true", "t", "yes", "y", "on", "1"},
+    False: []string{"false", "f", "no", "n", "off", "0"},
+  }
+)
+
+// lookup - returns the value of the word, ok is false if the word is not in the vocabulary
+func (w BoolWords) lookup(word string) (val bool, ok bool) {
+  for _, trueWord := range w.True {
+    if strings.EqualFold(word, strings.TrimSpace(trueWord)) {
+      return true, true
+    }
+  }
+  for _, falseWord := range w.False {
+    if strings.EqualFold(word, strings.TrimSpace(falseWord)) {
+      return false, true
+    }
+  }
+  return false, false
+}
+
+// boolWordValue - converts a string to bool using the vocabulary of the options (DefaultBoolWords if not set)
+// finite numeric strings that are not in DefaultBoolWords follow the numeric rule (not zero is true, 0 or 1 in strict mode), a custom vocabulary disables the rule
+// empty strings are false (an error in strict mode), other words return ErrorConvertorInvalidSyntax
+func boolWordValue(src any, str string, opt ConvertOptions) (dst bool, err zerror.Error) {
+  word := strings.TrimSpace(str)
+  if word == "" {
+    if opt.Strict {
+      return false, strictInvalidSyntaxError(src, "bool", errors.New("empty string"))
+    }
+    return false, nil
+  }
+  if opt.BoolWords != nil { // a custom vocabulary is the only accepted set of words
+    if val, ok := opt.BoolWords.lookup(word); ok {
+      return val, nil
+    }
+    return false, strictInvalidSyntaxError(src, "bool", errors.New("unknown bool word"))
+  }
+  if val, ok := DefaultBoolWords.lookup(word); ok {
+    return val, nil
+  }
+  if floatVal, er := strconv.ParseFloat(word, 64); er == nil && !math.IsNaN(floatVal) && !math.IsInf(floatVal, 0) {
+    if opt.Strict && floatVal != 0 && floatVal != 1 {
+      return false, strictPrecisionLossError(src, "bool")
+    }
+    return floatVal != 0, nil
+  }
+  return false, strictInvalidSyntaxError(src, "bool", errors.New("unknown bool word"))
+}
+
+// boolString - returns the first word of the true or false list of the vocabulary, "true" or "false" if the list is empty
+func boolString(val bool, opt ConvertOptions) string {
+  words := DefaultBoolWords
+  if opt.BoolWords != nil {
+    words = *opt.BoolWords
+  }
+  list, word := words.False, "false"
+  if val {
+    list, word = words.True, "true"
+  }
+  if len(list) > 0 {
+    return strings.TrimSpace(list[0])
+  }
+  return word
+}
@@ -0,0 +1,80 @@
+package zgen
+
+import (
+  "testing"
+
+  "github.com/stretchr/testify/assert"
+)
+
+func TestUnit_BoolWords(t *testing.T) {
+  custom := ConvertOptions{BoolWords: &BoolWords{True: []string{"enabled", "ja"}, False: []string{"disabled", "nein"}}}
+
+  tests := []struct {
+    name      string
+    input     any
+    opts      []ConvertOptions
+    expect    bool
+    errorCode string
+  }{
+    {name: "true", input: "TRUE", expect: true},
+    {name: "t", input: "t", expect: true},
+    {name: "yes", input: "yes", expect: true},
+    {name: "y", input: "Y", expect: true},
+    {name: "on", input: "On", expect: true},
+    {name: "1", input: "1", expect: true},
+    {name: "false", input: "False", expect: false},
+    {name: "f", input: "F", expect: false},
+    {name: "no", input: "NO", expect: false},
+    {name: "n", input: "n", expect: false},
+    {name: "off", input: "off", expect: false},
+    {name: "0", input: "0", expect: false},
+    {name: "numeric", input: "-2.5", expect: true},
+    {name: "numeric zero", input: "0.0", expect: false},
+    {name: "bytes use the same words", input: []byte(" on "), expect: true},
+    {name: "unknown", input: "enabled", errorCode: ErrorConvertorInvalidSyntax},
+    {name: "custom true", input: "Enabled", opts: []ConvertOptions{custom}, expect: true},
+    {name: "custom false", input: []byte("nein"), opts: []ConvertOptions{custom}, expect: false},
+    {name: "custom replaces the defaults", input: "yes", opts: []ConvertOptions{custom}, errorCode: ErrorConvertorInvalidSyntax},
+    {name: "custom without numbers", input: "1", opts: []ConvertOptions{custom}, errorCode: ErrorConvertorInvalidSyntax},
+  }
+
+  for _, tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      res, err := Bool(tt.input, tt.opts...)
+      if tt.errorCode != "" {
+        if assert.NotNil(t, err) {
+          assert.True(t, err.Has(tt.errorCode), "Expected error code '%s' but got '%s'", tt.errorCode, err.Error())
+        }
+        return
+      }
+      assert.Nil(t, err)
+      assert.Equal(t, tt.expect, res)
+    })
+  }
+}
+
+func TestUnit_BoolWordsToStruct(t *testing.T) {
+  dst := struct {
+    Active  bool     `json:"active"`
+    Visible NullBool `json:"visible"`
+  }{}
+
+  err := ToStruct(&dst, map[string]any{"active": "yes", "visible": "off"})
+  assert.Nil(t, err)
+  assert.True(t, dst.Active)
+  assert.True(t, dst.Visible.Valid)
+  assert.False(t, dst.Visible.Bool)
+
+  err = ToStruct(&dst, map[string]any{"active": "maybe"})
+  if assert.NotNil(t, err) {
+    assert.True(t, err.Has(ErrorConvertorInvalidSyntax))
+  }
+
+  config := DefaultParserConfig
+  config.BoolWords = &BoolWords{True: []string{"ja"}, False: []string{"nein"}}
+  err = ToStruct(&dst, config, map[string]any{"active": "nein", "visible": []byte("ja")})
+  assert.Nil(t, err)
+  assert.False(t, dst.Active)
+  assert.True(t, dst.Visible.Valid)
+  assert.True(t, dst.Visible.Bool)
+}
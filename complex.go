@@ -0,0 +1,108 @@
+package zgen
+
+import (
+  "github.com/znxlc/zerror"
+  "math/cmplx"
+  "reflect"
+  "sort"
+  "strings"
+)
+
+// complexValue - converts the complex sources that are not numbers to complex128, other sources are returned unchanged
+//   - pairs, arrays and slices of two numbers: [real, imag], or [magnitude, phase in radians] with ConvertOptions.ComplexPolar
+//   - maps with "real" and "imag" keys, or "abs" and "phase" keys for the polar form (missing parts are 0)
+//   - strings with spaces around the sign ("1 + 2i"), the spaces are removed before strconv.ParseComplex
+func complexValue(src any, dstType string, opt ConvertOptions) (dst any, err zerror.Error) {
+  switch val := src.(type) {
+  case string:
+    return complexString(val), nil
+  case []byte:
+    return complexString(string(val)), nil
+  }
+  srcValue := reflect.ValueOf(src)
+  switch srcValue.Kind() {
+  case reflect.Slice, reflect.Array:
+    if srcValue.Len() != 2 {
+      return src, zerror.New(ErrorConvertorTypeNotSupported, map[string]any{
+        "src":      src,
+        "src_type": srcValue.Type().String(),
+        "dst_type": dstType,
+        "error":    "complex pairs must have 2 elements",
+      })
+    }
+    parts := [2]float64{}
+    for idx := range parts {
+      part, zer := Float64(srcValue.Index(idx).Interface(), opt)
+      if zer != nil {
+        err = zerror.New(ErrorConvertorElementFailed, map[string]any{
+          "index":    idx,
+          "src":      srcValue.Index(idx).Interface(),
+          "dst_type": dstType,
+        })
+        err.Add(zer.GetList())
+        return src, err
+      }
+      parts[idx] = part
+    }
+    if opt.ComplexPolar {
+      return cmplx.Rect(parts[0], parts[1]), nil
+    }
+    return complex(parts[0], parts[1]), nil
+  case reflect.Map:
+    if srcValue.Type().Key().Kind() != reflect.String {
+      break
+    }
+    parts := map[string]float64{}
+    keys := srcValue.MapKeys()
+    sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() }) // the errors name the same key on every call
+    for _, key := range keys {
+      name := strings.ToLower(strings.TrimSpace(key.String()))
+      switch name {
+      case "real", "imag", "abs", "phase":
+      default:
+        return src, zerror.New(ErrorConvertorTypeNotSupported, map[string]any{
+          "src":      src,
+          "src_type": srcValue.Type().String(),
+          "dst_type": dstType,
+          "error":    "unknown complex key " + key.String(),
+        })
+      }
+      part, zer := Float64(srcValue.MapIndex(key).Interface(), opt)
+      if zer != nil {
+        return src, mapEntryError(key.Interface(), dstType, zer)
+      }
+      parts[name] = part
+    }
+    _, hasReal := parts["real"]
+    _, hasImag := parts["imag"]
+    _, hasAbs := parts["abs"]
+    _, hasPhase := parts["phase"]
+    if (hasReal || hasImag) == (hasAbs || hasPhase) { // empty maps or both forms
+      return src, zerror.New(ErrorConvertorTypeNotSupported, map[string]any{
+        "src":      src,
+        "src_type": srcValue.Type().String(),
+        "dst_type": dstType,
+        "error":    "complex maps need real/imag or abs/phase keys",
+      })
+    }
+    if hasAbs || hasPhase {
+      return cmplx.Rect(parts["abs"], parts["phase"]), nil
+    }
+    return complex(parts["real"], parts["imag"]), nil
+  }
+  return src, nil
+}
+
+// complexString - removes the spaces around the signs and parentheses of complex strings ("( 1 + 2i )" is "(1+2i)")
+// strings with spaces between numbers are returned unchanged so the parser rejects them
+func complexString(val string) string {
+  fields := strings.Fields(val)
+  for idx := 1; idx < len(fields); idx++ {
+    prev, next := fields[idx-1], fields[idx]
+    if !strings.HasSuffix(prev, "+") && !strings.HasSuffix(prev, "-") && !strings.HasSuffix(prev, "(") &&
+      !strings.HasPrefix(next, "+") && !strings.HasPrefix(next, "-") && !strings.HasPrefix(next, ")") {
+      return val
+    }
+  }
+  return strings.Join(fields, "")
+}
@@ -0,0 +1,87 @@
+package zgen
+
+import (
+  "math"
+  "testing"
+
+  "github.com/stretchr/testify/assert"
+)
+
+func TestUnit_ComplexSources(t *testing.T) {
+  polar := ConvertOptions{ComplexPolar: true}
+
+  tests := []struct {
+    name      string
+    input     any
+    opts      []ConvertOptions
+    expect    complex128
+    errorCode string
+  }{
+    {name: "string", input: "1+2i", expect: complex(1, 2)},
+    {name: "parentheses", input: "(3-4i)", expect: complex(3, -4)},
+    {name: "spaces around the sign", input: " ( 1.5 - 2e1i ) ", expect: complex(1.5, -20)},
+    {name: "bytes with spaces", input: []byte("-1 + 0.5i"), expect: complex(-1, 0.5)},
+    {name: "imaginary only", input: "2i", expect: complex(0, 2)},
+    {name: "spaces between numbers", input: "1 2i", errorCode: ErrorConvertorTypeNotSupported},
+    {name: "float64 array", input: [2]float64{1, -2}, expect: complex(1, -2)},
+    {name: "any pair", input: []any{"3", 4}, expect: complex(3, 4)},
+    {name: "json pair", input: []any{float64(0.5), float64(-1)}, expect: complex(0.5, -1)},
+    {name: "polar pair", input: []any{2, math.Pi / 2}, opts: []ConvertOptions{polar}, expect: complex(2*math.Cos(math.Pi/2), 2)},
+    {name: "pair with three elements", input: []float64{1, 2, 3}, errorCode: ErrorConvertorTypeNotSupported},
+    {name: "pair with invalid element", input: []any{1, "x"}, errorCode: ErrorConvertorElementFailed},
+    {name: "real imag map", input: map[string]any{"real": 1, "imag": "-2"}, expect: complex(1, -2)},
+    {name: "real only map", input: map[string]float64{"Real": 7}, expect: complex(7, 0)},
+    {name: "polar map", input: map[string]any{"abs": 2, "phase": math.Pi}, expect: complex(-2, 2*math.Sin(math.Pi))},
+    {name: "mixed map", input: map[string]any{"real": 1, "phase": 2}, errorCode: ErrorConvertorTypeNotSupported},
+    {name: "unknown key", input: map[string]any{"real": 1, "i": 2}, errorCode: ErrorConvertorTypeNotSupported},
+    {name: "empty map", input: map[string]any{}, errorCode: ErrorConvertorTypeNotSupported},
+    {name: "invalid map value", input: map[string]any{"imag": "x"}, errorCode: ErrorConvertorElementFailed},
+  }
+
+  for _, tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      res, err := Complex128(tt.input, tt.opts...)
+      if tt.errorCode != "" {
+        if assert.NotNil(t, err) {
+          assert.True(t, err.Has(tt.errorCode), "Expected error code '%s' but got '%s'", tt.errorCode, err.Error())
+        }
+        return
+      }
+      assert.Nil(t, err)
+      assert.InDelta(t, real(tt.expect), real(res), 1e-12)
+      assert.InDelta(t, imag(tt.expect), imag(res), 1e-12)
+
+      res64, err := Complex64(tt.input, tt.opts...)
+      assert.Nil(t, err)
+      assert.InDelta(t, real(tt.expect), float64(real(res64)), 1e-6)
+      assert.InDelta(t, imag(tt.expect), float64(imag(res64)), 1e-6)
+    })
+  }
+}
+
+func TestUnit_ComplexToStruct(t *testing.T) {
+  dst := struct {
+    Impedance complex128   `json:"impedance"`
+    Signal    complex64    `json:"signal"`
+    Phasor    complex128   `json:"phasor"`
+    Samples   []complex128 `json:"samples"`
+  }{}
+
+  err := ToStruct(&dst, map[string]any{
+    "impedance": []any{float64(50), float64(-25)},
+    "signal":    "1 + 1i",
+    "phasor":    map[string]any{"abs": float64(1), "phase": float64(0)},
+    "samples":   []any{[]any{float64(1), float64(0)}, "0+1i"},
+  })
+  assert.Nil(t, err)
+  assert.Equal(t, complex(50, -25), dst.Impedance)
+  assert.Equal(t, complex64(complex(1, 1)), dst.Signal)
+  assert.Equal(t, complex(1, 0), dst.Phasor)
+  assert.Equal(t, []complex128{complex(1, 0), complex(0, 1)}, dst.Samples)
+
+  config := DefaultParserConfig
+  config.ComplexPolar = true
+  err = ToStruct(&dst, config, map[string]any{"impedance": []any{float64(2), float64(0)}})
+  assert.Nil(t, err)
+  assert.Equal(t, complex(2, 0), dst.Impedance)
+}
@@ -1,18 +1,30 @@
 package zgen
 
 import (
+  "errors"
   "github.com/znxlc/zerror"
   "math"
+  "math/big"
+  "net"
+  "net/netip"
+  "net/url"
   "reflect"
   "strconv"
   "strings"
   "time"
 
+  "github.com/araddon/dateparse"
+  "github.com/gofrs/uuid"
   "github.com/shopspring/decimal"
 )
 
 // Int - tries to convert any to int (conversion loss may occur)
-func Int(src any) (dst int, err zerror.Error) {
+func Int(src any, opts ...ConvertOptions) (dst int, err zerror.Error) {
+  return convertInteger(src, "int", intValue, opts)
+}
+
+// intValue - converts any to int without applying the conversion options
+func intValue(src any) (dst int, err zerror.Error) {
   if src == nil {
     return 0, nil
   }
@@ -180,11 +192,15 @@ func Int(src any) (dst int, err zerror.Error) {
 }
 
 // Uint - tries to convert any to uint (conversion loss may occur)
-func Uint(src any) (dst uint, err zerror.Error) {
+func Uint(src any, opts ...ConvertOptions) (dst uint, err zerror.Error) {
+  return convertInteger(src, "uint", uintValue, opts)
+}
+
+// uintValue - converts any to uint without applying the conversion options
+func uintValue(src any) (dst uint, err zerror.Error) {
   if src == nil {
     return 0, nil
   }
-
   switch val := src.(type) {
   case uint:
     return val, nil
@@ -370,7 +386,12 @@ func Uint(src any) (dst uint, err zerror.Error) {
 }
 
 // Int64 - tries to convert any to int64
-func Int64(src any) (dst int64, err zerror.Error) {
+func Int64(src any, opts ...ConvertOptions) (dst int64, err zerror.Error) {
+  return convertInteger(src, "int64", int64Value, opts)
+}
+
+// int64Value - converts any to int64 without applying the conversion options
+func int64Value(src any) (dst int64, err zerror.Error) {
   if src == nil {
     return 0, nil
   }
@@ -452,7 +473,12 @@ func Int64(src any) (dst int64, err zerror.Error) {
 }
 
 // Int32 - tries to convert any to int32, data may be lost in the conversion so use at your own risk
-func Int32(src any) (dst int32, err zerror.Error) {
+func Int32(src any, opts ...ConvertOptions) (dst int32, err zerror.Error) {
+  return convertInteger(src, "int32", int32Value, opts)
+}
+
+// int32Value - converts any to int32 without applying the conversion options
+func int32Value(src any) (dst int32, err zerror.Error) {
   if src == nil {
     return 0, nil
   }
@@ -630,7 +656,12 @@ func Int32(src any) (dst int32, err zerror.Error) {
 }
 
 // Int16 - tries to convert any to int16 (conversion loss may occur)
-func Int16(src any) (dst int16, err zerror.Error) {
+func Int16(src any, opts ...ConvertOptions) (dst int16, err zerror.Error) {
+  return convertInteger(src, "int16", int16Value, opts)
+}
+
+// int16Value - converts any to int16 without applying the conversion options
+func int16Value(src any) (dst int16, err zerror.Error) {
   if src == nil {
     return 0, nil
   }
@@ -826,7 +857,12 @@ func Int16(src any) (dst int16, err zerror.Error) {
 }
 
 // Int8 - tries to convert any to int8 (conversion loss may occur)
-func Int8(src any) (dst int8, err zerror.Error) {
+func Int8(src any, opts ...ConvertOptions) (dst int8, err zerror.Error) {
+  return convertInteger(src, "int8", int8Value, opts)
+}
+
+// int8Value - converts any to int8 without applying the conversion options
+func int8Value(src any) (dst int8, err zerror.Error) {
   if src == nil {
     return 0, nil
   }
@@ -1054,7 +1090,12 @@ func Int8(src any) (dst int8, err zerror.Error) {
 }
 
 // Uint64 - tries to convert any to uint64
-func Uint64(src any) (dst uint64, err zerror.Error) {
+func Uint64(src any, opts ...ConvertOptions) (dst uint64, err zerror.Error) {
+  return convertInteger(src, "uint64", uint64Value, opts)
+}
+
+// uint64Value - converts any to uint64 without applying the conversion options
+func uint64Value(src any) (dst uint64, err zerror.Error) {
   if src == nil {
     return 0, nil
   }
@@ -1254,7 +1295,12 @@ func Uint64(src any) (dst uint64, err zerror.Error) {
 }
 
 // Uint32 - tries to convert any to uint32 (conversion loss may occur)
-func Uint32(src any) (dst uint32, err zerror.Error) {
+func Uint32(src any, opts ...ConvertOptions) (dst uint32, err zerror.Error) {
+  return convertInteger(src, "uint32", uint32Value, opts)
+}
+
+// uint32Value - converts any to uint32 without applying the conversion options
+func uint32Value(src any) (dst uint32, err zerror.Error) {
   if src == nil {
     return 0, nil
   }
@@ -1469,7 +1515,12 @@ func Uint32(src any) (dst uint32, err zerror.Error) {
 }
 
 // Uint16 - tries to convert any to uint16 (conversion loss may occur)
-func Uint16(src any) (dst uint16, err zerror.Error) {
+func Uint16(src any, opts ...ConvertOptions) (dst uint16, err zerror.Error) {
+  return convertInteger(src, "uint16", uint16Value, opts)
+}
+
+// uint16Value - converts any to uint16 without applying the conversion options
+func uint16Value(src any) (dst uint16, err zerror.Error) {
   if src == nil {
     return 0, nil
   }
@@ -1691,7 +1742,12 @@ func Uint16(src any) (dst uint16, err zerror.Error) {
 }
 
 // Uint8 - tries to convert any to uint8 (conversion loss may occur)
-func Uint8(src any) (dst uint8, err zerror.Error) {
+func Uint8(src any, opts ...ConvertOptions) (dst uint8, err zerror.Error) {
+  return convertInteger(src, "uint8", uint8Value, opts)
+}
+
+// uint8Value - converts any to uint8 without applying the conversion options
+func uint8Value(src any) (dst uint8, err zerror.Error) {
   if src == nil {
     return 0, nil
   }
@@ -1920,10 +1976,34 @@ func Uint8(src any) (dst uint8, err zerror.Error) {
 }
 
 // Float64 - tries to convert any to float64
-func Float64(src any) (dst float64, err zerror.Error) {
+func Float64(src any, opts ...ConvertOptions) (dst float64, err zerror.Error) {
   if src == nil {
     return 0, nil
   }
+  opt := getConvertOptions(opts)
+  if res, found, zer := convertRegistered[float64](src, opt.Converters); found { // custom registered converter
+    if zer != nil {
+      return 0, zer
+    }
+    return res, nil
+  }
+  src = jsonNumberValue(src)
+  src, err = applyNonFinite(src, "float64", opt)
+  if err != nil || src == nil {
+    return 0, err
+  }
+  if val, ok := nonFiniteValue(src); ok && opt.NonFinite == NonFiniteAllow {
+    return val, nil
+  }
+  src, err = applyNumberFormat(src, opt.NumberFormat, "float64")
+  if err != nil {
+    return 0, err
+  }
+  if opt.Strict {
+    if err = strictFloat(src, "float64", 64); err != nil {
+      return 0, err
+    }
+  }
   switch val := src.(type) {
   case float64:
     return val, nil
@@ -2039,6 +2119,8 @@ func Float64(src any) (dst float64, err zerror.Error) {
       })
     }
     return floatValue, nil
+  case *big.Int, *big.Float, *big.Rat:
+    return bigFloatValue(val, "float64", 64, opt.Strict)
   default:
     return 0, zerror.New(ErrorConvertorTypeNotSupported, map[string]any{
       "src":      val,
@@ -2049,10 +2131,34 @@ func Float64(src any) (dst float64, err zerror.Error) {
 }
 
 // Float32 - tries to convert any to float32(conversion loss may occur)
-func Float32(src any) (dst float32, err zerror.Error) {
+func Float32(src any, opts ...ConvertOptions) (dst float32, err zerror.Error) {
   if src == nil {
     return 0, nil
   }
+  opt := getConvertOptions(opts)
+  if res, found, zer := convertRegistered[float32](src, opt.Converters); found { // custom registered converter
+    if zer != nil {
+      return 0, zer
+    }
+    return res, nil
+  }
+  src = jsonNumberValue(src)
+  src, err = applyNonFinite(src, "float32", opt)
+  if err != nil || src == nil {
+    return 0, err
+  }
+  if val, ok := nonFiniteValue(src); ok && opt.NonFinite == NonFiniteAllow {
+    return float32(val), nil
+  }
+  src, err = applyNumberFormat(src, opt.NumberFormat, "float32")
+  if err != nil {
+    return 0, err
+  }
+  if opt.Strict {
+    if err = strictFloat(src, "float32", 32); err != nil {
+      return 0, err
+    }
+  }
   switch val := src.(type) {
   case float32:
     return val, nil
@@ -2177,6 +2283,9 @@ func Float32(src any) (dst float32, err zerror.Error) {
       })
     }
     return float32(floatVal), nil
+  case *big.Int, *big.Float, *big.Rat:
+    floatVal, err := bigFloatValue(val, "float32", 32, opt.Strict)
+    return float32(floatVal), err
   default:
     return 0, zerror.New(ErrorConvertorTypeNotSupported, map[string]any{
       "src":      val,
@@ -2187,10 +2296,31 @@ func Float32(src any) (dst float32, err zerror.Error) {
 }
 
 // Complex64 - tries to convert any to complex64(conversion loss may occur because complex64 uses 2 float32 behind the scenes)
-func Complex64(src any) (dst complex64, err zerror.Error) {
+// accepts numbers, strconv.ParseComplex strings ("1+2i", "(3-4i)", "1 + 2i"), [real, imag] pairs and maps with real/imag or abs/phase keys
+func Complex64(src any, opts ...ConvertOptions) (dst complex64, err zerror.Error) {
   if src == nil {
     return 0, nil
   }
+  opt := getConvertOptions(opts)
+  if res, found, zer := convertRegistered[complex64](src, opt.Converters); found { // custom registered converter
+    if zer != nil {
+      return 0, zer
+    }
+    return res, nil
+  }
+  src = jsonNumberValue(src)
+  src, err = complexValue(src, "complex64", opt) // pairs, real/imag maps and spaced strings
+  if err != nil {
+    return 0, err
+  }
+  if formatted, er := applyNumberFormat(src, opt.NumberFormat, ""); er == nil { // complex strings ("1+2i") do not match the number formats
+    src = formatted
+  }
+  if opt.Strict {
+    if err = strictComplex(src, "complex64", 64); err != nil {
+      return 0, err
+    }
+  }
   switch val := src.(type) {
   case complex64:
     return val, nil
@@ -2307,10 +2437,31 @@ func Complex64(src any) (dst complex64, err zerror.Error) {
 }
 
 // Complex128 - tries to convert any to complex128
-func Complex128(src any) (dst complex128, err zerror.Error) {
+// accepts numbers, strconv.ParseComplex strings ("1+2i", "(3-4i)", "1 + 2i"), [real, imag] pairs and maps with real/imag or abs/phase keys
+func Complex128(src any, opts ...ConvertOptions) (dst complex128, err zerror.Error) {
   if src == nil {
     return 0, nil
   }
+  opt := getConvertOptions(opts)
+  if res, found, zer := convertRegistered[complex128](src, opt.Converters); found { // custom registered converter
+    if zer != nil {
+      return 0, zer
+    }
+    return res, nil
+  }
+  src = jsonNumberValue(src)
+  src, err = complexValue(src, "complex128", opt) // pairs, real/imag maps and spaced strings
+  if err != nil {
+    return 0, err
+  }
+  if formatted, er := applyNumberFormat(src, opt.NumberFormat, ""); er == nil { // complex strings ("1+2i") do not match the number formats
+    src = formatted
+  }
+  if opt.Strict {
+    if err = strictComplex(src, "complex128", 128); err != nil {
+      return 0, err
+    }
+  }
   switch val := src.(type) {
   case complex128:
     return val, nil
@@ -2420,10 +2571,54 @@ func Complex128(src any) (dst complex128, err zerror.Error) {
 }
 
 // Decimal - tries to convert any to decimal
-func Decimal(src any) (dst decimal.Decimal, err zerror.Error) {
+// floats use their shortest representation (or the exact binary value with ConvertOptions.DecimalExactFloat)
+// NaN and infinite values return ErrorConvertorNumberOverflow unless ConvertOptions.NonFinite rejects them or converts them to zero
+// with ConvertOptions.DecimalScale the results are rounded to the scale using ConvertOptions.Rounding
+func Decimal(src any, opts ...ConvertOptions) (dst decimal.Decimal, err zerror.Error) {
   if src == nil {
     return decimal.NewFromInt(0), nil
   }
+  opt := getConvertOptions(opts)
+  if res, found, zer := convertRegistered[decimal.Decimal](src, opt.Converters); found { // custom registered converter
+    if zer != nil {
+      return decimal.NewFromInt(0), zer
+    }
+    return res, nil
+  }
+  src = jsonNumberValue(src)
+  src, err = applyNonFinite(src, "decimal", opt)
+  if err != nil || src == nil {
+    return decimal.NewFromInt(0), err
+  }
+  if opt.DecimalScale != nil { // converting without the scale then rounding the result
+    unscaledOpt := opt
+    unscaledOpt.DecimalScale = nil
+    dst, err = Decimal(src, unscaledOpt)
+    if err != nil {
+      return decimal.NewFromInt(0), err
+    }
+    rounded := roundDecimal(dst, *opt.DecimalScale, opt.Rounding)
+    if opt.Strict && !rounded.Equal(dst) {
+      return decimal.NewFromInt(0), strictPrecisionLossError(src, "decimal")
+    }
+    return rounded, nil
+  }
+  src, err = applyNumberFormat(src, opt.NumberFormat, "decimal")
+  if err != nil {
+    return decimal.NewFromInt(0), err
+  }
+  if opt.Strict {
+    if err = strictDecimal(src, "decimal"); err != nil {
+      return decimal.NewFromInt(0), err
+    }
+  }
+  if _, ok := nonFiniteValue(src); ok { // decimals can not represent NaN and infinite values
+    return decimal.NewFromInt(0), zerror.New(ErrorConvertorNumberOverflow, map[string]any{
+      "from_type": reflect.TypeOf(src).String(),
+      "to_type":   "decimal",
+      "value":     src,
+    })
+  }
   switch val := src.(type) {
   case decimal.Decimal:
     return val, nil
@@ -2437,8 +2632,8 @@ func Decimal(src any) (dst decimal.Decimal, err zerror.Error) {
     return decimal.NewFromInt(int64(val)), nil
   case int64:
     return decimal.NewFromInt(val), nil
-  case uint:
-    return decimal.NewFromInt(int64(val)), nil
+  case uint: // big.Int is used to keep values above math.MaxInt64 intact
+    return decimal.NewFromBigInt(new(big.Int).SetUint64(uint64(val)), 0), nil
   case uint8:
     return decimal.NewFromInt(int64(val)), nil
   case uint16:
@@ -2446,15 +2641,15 @@ func Decimal(src any) (dst decimal.Decimal, err zerror.Error) {
   case uint32:
     return decimal.NewFromInt(int64(val)), nil
   case uint64:
-    return decimal.NewFromInt(int64(val)), nil
+    return decimal.NewFromBigInt(new(big.Int).SetUint64(val), 0), nil
   case float32:
-    return decimal.NewFromFloat32(val), nil
+    return floatDecimal(float64(val), 32, opt)
   case float64:
-    return decimal.NewFromFloat(val), nil
+    return floatDecimal(val, 64, opt)
   case complex64:
-    return decimal.NewFromFloat32(real(val)), nil
+    return floatDecimal(float64(real(val)), 32, opt)
   case complex128:
-    return decimal.NewFromFloat(real(val)), nil
+    return floatDecimal(real(val), 64, opt)
   case time.Duration:
     return decimal.NewFromInt(int64(val)), nil
   case time.Time: // return the unix value
@@ -2486,6 +2681,8 @@ func Decimal(src any) (dst decimal.Decimal, err zerror.Error) {
       })
     }
     return decval, err
+  case *big.Int, *big.Float, *big.Rat:
+    return bigDecimal(val, "decimal", opt.Strict)
   default:
     return decimal.NewFromInt(0), zerror.New(ErrorConvertorTypeNotSupported, map[string]any{
       "src":      val,
@@ -2495,10 +2692,52 @@ func Decimal(src any) (dst decimal.Decimal, err zerror.Error) {
   }
 }
 
-// String - tries to convert any to string
+// String - tries to convert any to string, see StringWithOptions for the output formats
 func String(src any) (dst string, err zerror.Error) {
-  if src == nil {
-    return "", nil
+  return StringWithOptions(src, DefaultConvertOptions)
+}
+
+// StringWithOptions - tries to convert any to string, the output format is set by the options
+// floats use FloatFormat and FloatPrecision, times TimeLayout (in TimeLayoutStyle, TimeFormatISOSTZ if empty) and NormalizeLocation,
+// durations DurationStyle and DurationUnit, decimals DecimalScale and Rounding, bools the first words of BoolWords, nil values NilString
+// and NaN or infinite numbers NonFinite, with a NumberFormat the numbers are written by FormatNumber instead
+// the zero value options keep the String formats
+func StringWithOptions(src any, opt ConvertOptions) (dst string, err zerror.Error) {
+  if src == nil || (reflect.TypeOf(src).Kind() == reflect.Ptr && reflect.ValueOf(src).IsNil()) {
+    return opt.NilString, nil
+  }
+  if res, found, zer := convertRegistered[string](src, opt.Converters); found { // custom registered converter
+    if zer != nil {
+      return "", zer
+    }
+    return res, nil
+  }
+  switch src.(type) {
+  case string, []byte: // text is returned as is, "NaN" included
+  default:
+    src, err = applyNonFinite(src, "string", opt)
+    if err != nil {
+      return "", err
+    }
+    if src == nil {
+      return opt.NilString, nil
+    }
+  }
+  if opt.NumberFormat != nil { // numbers are written with the locale format
+    switch val := src.(type) {
+    case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+      return FormatNumber(val, *opt.NumberFormat)
+    case decimal.Decimal:
+      format := *opt.NumberFormat
+      if opt.DecimalScale != nil {
+        val = roundDecimal(val, *opt.DecimalScale, opt.Rounding)
+        if format.Precision == nil {
+          precision := int(*opt.DecimalScale)
+          format.Precision = &precision
+        }
+      }
+      return FormatNumber(val, format)
+    }
   }
   switch val := src.(type) {
   case string:
@@ -2506,16 +2745,13 @@ func String(src any) (dst string, err zerror.Error) {
   case []byte:
     return string(val), nil
   case []any: // will try to convert to []byte
-    sliceByte, err := SliceByte(val)
+    sliceByte, err := SliceByte(val, opt)
     if err != nil {
       return "", err
     }
     return string(sliceByte), nil
   case bool:
-    if val {
-      return "true", nil
-    }
-    return "false", nil
+    return boolString(val, opt), nil
   case int:
     return strconv.FormatInt(int64(val), 10), nil
   case int8:
@@ -2537,20 +2773,39 @@ func String(src any) (dst string, err zerror.Error) {
   case uint64:
     return strconv.FormatUint(val, 10), nil
   case time.Duration:
-    return val.String(), nil
-    // return strconv.FormatInt(int64(val), 10), nil
-  case float32:
-    return strconv.FormatFloat(float64(val), 'g', -1, 64), nil
+    return durationString(val, opt), nil
+  case float32: // formatted as the float64 value, like String
+    return floatString(float64(val), 'g', 64, opt), nil
   case float64:
-    return strconv.FormatFloat(val, 'f', -1, 64), nil
+    return floatString(val, 'f', 64, opt), nil
   case complex64:
     return strconv.FormatComplex(complex128(val), 'g', -1, 64), nil
   case complex128:
     return strconv.FormatComplex(val, 'g', -1, 128), nil
-  case time.Time: // return the standard ISO STZ format
-    return val.Format(TimeFormatISOSTZ), nil
-  case decimal.Decimal:
+  case time.Time: // the standard ISO STZ format if no layout is set
+    return timeString(val, opt)
+  case decimal.Decimal: // fixed number of decimals with DecimalScale
+    if opt.DecimalScale != nil {
+      return roundDecimal(val, *opt.DecimalScale, opt.Rounding).StringFixed(*opt.DecimalScale), nil
+    }
     return val.String(), nil
+  case net.IP, netip.Addr, netip.Prefix, net.HardwareAddr, *net.IPNet, *url.URL:
+    return networkString(val), nil
+  case uuid.UUID:
+    return val.String(), nil
+  case uuid.NullUUID: // invalid null uuids are empty strings
+    if !val.Valid {
+      return "", nil
+    }
+    return val.UUID.String(), nil
+  case NullUUID:
+    return StringWithOptions(val.NullUUID, opt)
+  case *big.Int:
+    return val.String(), nil
+  case *big.Float: // the shortest representation that reads back to the same value, String() rounds to 10 digits
+    return val.Text('g', -1), nil
+  case *big.Rat: // integers without the "/1" denominator
+    return val.RatString(), nil
   case Stringable:
     return val.String(), nil
   case ToStringable:
@@ -2566,32 +2821,57 @@ func String(src any) (dst string, err zerror.Error) {
 }
 
 // Bool - tries to convert any to bool
-func Bool(src any) (dst bool, err zerror.Error) {
+// numbers are true if not zero, strings and []byte use the BoolWords vocabulary ("yes", "off", etc.) and return an error for unknown words, NaN and infinite values follow ConvertOptions.NonFinite
+func Bool(src any, opts ...ConvertOptions) (dst bool, err zerror.Error) {
   if src == nil {
     return false, nil
   }
+  opt := getConvertOptions(opts)
+  if res, found, zer := convertRegistered[bool](src, opt.Converters); found { // custom registered converter
+    if zer != nil {
+      return false, zer
+    }
+    return res, nil
+  }
+  src = jsonNumberValue(src)
+  src, err = applyNonFinite(src, "bool", opt)
+  if err != nil || src == nil {
+    return false, err
+  }
+  if _, ok := nonFiniteValue(src); ok { // true with NonFiniteAllow (not zero), an overflow with the default policy
+    if opt.NonFinite == NonFiniteAllow {
+      return true, nil
+    }
+    return false, zerror.New(ErrorConvertorNumberOverflow, map[string]any{
+      "from_type": reflect.TypeOf(src).String(),
+      "to_type":   "bool",
+      "value":     src,
+    })
+  }
+  if opt.Strict {
+    if err = strictBool(src, "bool"); err != nil {
+      return false, err
+    }
+  }
   switch val := src.(type) {
   case bool:
     return val, nil
-  case uint64, uint32, uint16, uint8, uint, int, int8, int16, int32, int64, float64, float32, time.Duration:
-    valF64, _ := Float64(val)
-    return valF64 != float64(0), nil
+  case int, int8, int16, int32, int64, time.Duration: // compared directly, Float64 returns an error above 2^53
+    return reflect.ValueOf(val).Int() != 0, nil
+  case uint, uint8, uint16, uint32, uint64:
+    return reflect.ValueOf(val).Uint() != 0, nil
+  case float32:
+    return val != 0, nil
+  case float64:
+    return val != 0, nil
   case complex64:
     return real(val) != float32(0) || imag(val) != float32(0), nil
   case complex128:
     return real(val) != float64(0) || imag(val) != float64(0), nil
   case []byte:
-    stringVal := strings.ToLower(string(val))
-    if stringVal == "" || stringVal == "false" { // element is empty or "false"
-      return false, nil
-    }
-    return true, nil
+    return boolWordValue(val, string(val), opt)
   case string:
-    tst, er := strconv.ParseBool(val)
-    if er != nil {
-      return false, nil
-    }
-    return tst, nil
+    return boolWordValue(val, val, opt)
   default:
     return false, zerror.New(ErrorConvertorTypeNotSupported, map[string]any{
       "src":      val,
@@ -2602,11 +2882,22 @@ func Bool(src any) (dst bool, err zerror.Error) {
 }
 
 // MapStringAny - tries to convert any to map[string]any
-func MapStringAny(src any) (dst map[string]any, err zerror.Error) {
+func MapStringAny(src any, opts ...ConvertOptions) (dst map[string]any, err zerror.Error) {
   result := map[string]any{}
   if src == nil {
     return result, nil
   }
+  opt := getConvertOptions(opts)
+  if res, found, zer := convertRegistered[map[string]any](src, opt.Converters); found { // custom registered converter
+    if zer != nil {
+      return result, zer
+    }
+    return res, nil
+  }
+  src, err = decodeString(src, "map[string]any", opt)
+  if err != nil {
+    return result, err
+  }
   // fast check to see the src type is same as dst to avoid fancy reflect operations
   if srcVal, ok := src.(map[string]any); ok {
     return srcVal, nil
@@ -2633,7 +2924,7 @@ func MapStringAny(src any) (dst map[string]any, err zerror.Error) {
     return result, nil
   case reflect.Ptr:
     unpackedVal := UnpackBaseElement(src, false) // removing pointer
-    return MapStringAny(unpackedVal)
+    return MapStringAny(unpackedVal, opt)
   }
   return result, zerror.New(ErrorConvertorTypeNotSupported, map[string]any{
     "src":      src,
@@ -2643,11 +2934,22 @@ func MapStringAny(src any) (dst map[string]any, err zerror.Error) {
 }
 
 // SliceAny - tries to convert any to []any
-func SliceAny(src any) (dst []any, err zerror.Error) {
+func SliceAny(src any, opts ...ConvertOptions) (dst []any, err zerror.Error) {
   result := []any{}
   if src == nil {
     return result, nil
   }
+  opt := getConvertOptions(opts)
+  if res, found, zer := convertRegistered[[]any](src, opt.Converters); found { // custom registered converter
+    if zer != nil {
+      return result, zer
+    }
+    return res, nil
+  }
+  src, err = decodeString(src, "[]any", opt)
+  if err != nil {
+    return result, err
+  }
   // fast check to see the src type is same as dst to avoid fancy reflect operations
   if srcVal, ok := src.([]any); ok {
     return srcVal, nil
@@ -2675,15 +2977,33 @@ func SliceAny(src any) (dst []any, err zerror.Error) {
 }
 
 // SliceByte - tries to convert any to []byte
-func SliceByte(src any) (dst []byte, err zerror.Error) {
+func SliceByte(src any, opts ...ConvertOptions) (dst []byte, err zerror.Error) {
   result := []byte{}
   if src == nil {
     return result, nil
   }
+  opt := getConvertOptions(opts)
+  if res, found, zer := convertRegistered[[]byte](src, opt.Converters); found { // custom registered converter
+    if zer != nil {
+      return result, zer
+    }
+    return res, nil
+  }
   // fast check to see the src type is same as dst to avoid fancy reflect operations
   if srcVal, ok := src.([]byte); ok {
     return srcVal, nil
   }
+  switch val := src.(type) { // uuids return their 16 raw bytes, invalid null uuids an empty slice
+  case uuid.UUID:
+    return val.Bytes(), nil
+  case uuid.NullUUID:
+    if !val.Valid {
+      return result, nil
+    }
+    return val.UUID.Bytes(), nil
+  case NullUUID:
+    return SliceByte(val.NullUUID, opts...)
+  }
 
   // converting other map types
   elemValue := reflect.ValueOf(src)
@@ -2692,7 +3012,7 @@ func SliceByte(src any) (dst []byte, err zerror.Error) {
   case reflect.Map, reflect.Struct, reflect.Chan, reflect.Func, reflect.Invalid:
   case reflect.Slice, reflect.Array:
     for i := 0; i < elemValue.Len(); i++ {
-      resByte, err := Uint8(elemValue.Index(i).Interface())
+      resByte, err := Uint8(elemValue.Index(i).Interface(), opts...)
       if err != nil {
         return result, err
       }
@@ -2700,7 +3020,10 @@ func SliceByte(src any) (dst []byte, err zerror.Error) {
     }
     return result, nil
   case reflect.String, reflect.Int, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Uint, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
-    stringVal, _ := String(src)
+    stringVal, err := StringWithOptions(src, opt)
+    if err != nil {
+      return result, err
+    }
     return []byte(stringVal), nil
   }
   return result, zerror.New(ErrorConvertorTypeNotSupported, map[string]any{
@@ -2712,11 +3035,22 @@ func SliceByte(src any) (dst []byte, err zerror.Error) {
 }
 
 // SliceString - tries to convert any to []string
-func SliceString(src any) (dst []string, err zerror.Error) {
+func SliceString(src any, opts ...ConvertOptions) (dst []string, err zerror.Error) {
   result := []string{}
   if src == nil {
     return result, nil
   }
+  opt := getConvertOptions(opts)
+  if res, found, zer := convertRegistered[[]string](src, opt.Converters); found { // custom registered converter
+    if zer != nil {
+      return result, zer
+    }
+    return res, nil
+  }
+  src, err = decodeString(src, "[]string", opt)
+  if err != nil {
+    return result, err
+  }
   // fast check to see the src type is same as dst to avoid fancy reflect operations
   if srcVal, ok := src.([]string); ok {
     return srcVal, nil
@@ -2729,7 +3063,7 @@ func SliceString(src any) (dst []string, err zerror.Error) {
   case reflect.Map, reflect.Struct, reflect.Chan, reflect.Func, reflect.Invalid:
   case reflect.Slice, reflect.Array:
     for i := 0; i < elemValue.Len(); i++ {
-      resString, err := String(elemValue.Index(i).Interface())
+      resString, err := StringWithOptions(elemValue.Index(i).Interface(), opt)
       if err != nil {
         return result, err
       }
@@ -2737,7 +3071,7 @@ func SliceString(src any) (dst []string, err zerror.Error) {
     }
     return result, nil
   default: // simple type, we convert to string and add it as a slice element
-    resString, err := String(elemValue.Interface())
+    resString, err := StringWithOptions(elemValue.Interface(), opt)
     if err != nil {
       return result, err
     }
@@ -2754,11 +3088,22 @@ func SliceString(src any) (dst []string, err zerror.Error) {
 }
 
 // SliceInt - tries to convert any to []int
-func SliceInt(src any) (dst []int, err zerror.Error) {
+func SliceInt(src any, opts ...ConvertOptions) (dst []int, err zerror.Error) {
   result := []int{}
   if src == nil {
     return result, nil
   }
+  opt := getConvertOptions(opts)
+  if res, found, zer := convertRegistered[[]int](src, opt.Converters); found { // custom registered converter
+    if zer != nil {
+      return result, zer
+    }
+    return res, nil
+  }
+  src, err = decodeString(src, "[]int", opt)
+  if err != nil {
+    return result, err
+  }
   // fast check to see the src type is same as dst to avoid fancy reflect operations
   if srcVal, ok := src.([]int); ok {
     return srcVal, nil
@@ -2771,7 +3116,7 @@ func SliceInt(src any) (dst []int, err zerror.Error) {
   case reflect.Map, reflect.Struct, reflect.Chan, reflect.Func, reflect.Invalid:
   case reflect.Slice, reflect.Array:
     for i := 0; i < elemValue.Len(); i++ {
-      resInt, err := Int(elemValue.Index(i).Interface())
+      resInt, err := Int(elemValue.Index(i).Interface(), opts...)
       if err != nil {
         return result, err
       }
@@ -2779,7 +3124,7 @@ func SliceInt(src any) (dst []int, err zerror.Error) {
     }
     return result, nil
   default:
-    resInt, err := Int(elemValue.Interface())
+    resInt, err := Int(elemValue.Interface(), opts...)
     if err != nil {
       return result, err
     }
@@ -2793,12 +3138,198 @@ func SliceInt(src any) (dst []int, err zerror.Error) {
   })
 }
 
+// Slice - generic slice converter, converts every element of src to T using To
+// accepts slices, arrays, single values (one element) and strings decoded with ConvertOptions.Separator or JSONStrings
+// the errors of the elements are wrapped in ErrorConvertorElementFailed with the index of the failing element
+func Slice[T any](src any, opts ...ConvertOptions) (dst []T, err zerror.Error) {
+  result := []T{}
+  if src == nil {
+    return result, nil
+  }
+  opt := getConvertOptions(opts)
+  if res, found, zer := convertRegistered[[]T](src, opt.Converters); found { // custom registered converter
+    if zer != nil {
+      return result, zer
+    }
+    return res, nil
+  }
+  // fast check to see the src type is same as dst to avoid fancy reflect operations
+  if srcVal, ok := src.([]T); ok {
+    return srcVal, nil
+  }
+
+  dstType := reflect.TypeOf(result).String()
+  src, err = decodeString(src, dstType, opt)
+  if err != nil {
+    return result, err
+  }
+
+  elements := []any{}
+  elemValue := reflect.ValueOf(src)
+  switch elemValue.Kind() {
+  case reflect.Map, reflect.Chan, reflect.Func:
+    return result, zerror.New(ErrorConvertorTypeNotSupported, map[string]any{
+      "src":      src,
+      "src_type": elemValue.Kind().String(),
+      "dst_type": dstType,
+    })
+  case reflect.Slice, reflect.Array:
+    for i := 0; i < elemValue.Len(); i++ {
+      elements = append(elements, elemValue.Index(i).Interface())
+    }
+  default: // single value (including structs like time.Time or decimal.Decimal)
+    elements = append(elements, src)
+  }
+
+  for idx, element := range elements {
+    resElement, zer := To[T](element, opt)
+    if zer != nil {
+      err = zerror.New(ErrorConvertorElementFailed, map[string]any{
+        "index":    idx,
+        "src":      element,
+        "dst_type": reflect.TypeOf(result).Elem().String(),
+      })
+      err.Add(zer.GetList())
+      return []T{}, err
+    }
+    result = append(result, resElement)
+  }
+  return result, nil
+}
+
+// Map - generic map converter, converts the keys of src to K and the values to V using To
+// accepts maps, structs (converted with ToMap) and slices of key/value pairs ([]any{key, value} or map[string]any{"key": key, "value": value})
+// the errors of the entries are wrapped in ErrorConvertorElementFailed with the key of the failing entry, source keys converted to the same key included
+func Map[K comparable, V any](src any, opts ...ConvertOptions) (dst map[K]V, err zerror.Error) {
+  result := map[K]V{}
+  if src == nil {
+    return result, nil
+  }
+  opt := getConvertOptions(opts)
+  if res, found, zer := convertRegistered[map[K]V](src, opt.Converters); found { // custom registered converter
+    if zer != nil {
+      return result, zer
+    }
+    return res, nil
+  }
+  // fast check to see the src type is same as dst to avoid fancy reflect operations
+  if srcVal, ok := src.(map[K]V); ok {
+    return srcVal, nil
+  }
+
+  dstType := reflect.TypeOf(result).String()
+  src, err = decodeString(src, dstType, opt)
+  if err != nil {
+    return result, err
+  }
+  keys, values, err := mapEntries(src, dstType)
+  if err != nil {
+    return result, err
+  }
+  for idx := range keys {
+    key, zer := To[K](keys[idx], opt)
+    if zer != nil {
+      return map[K]V{}, mapEntryError(keys[idx], dstType, zer)
+    }
+    if _, exists := result[key]; exists {
+      return map[K]V{}, duplicateKeyError(keys[idx], dstType)
+    }
+    value, zer := To[V](values[idx], opt)
+    if zer != nil {
+      return map[K]V{}, mapEntryError(keys[idx], dstType, zer)
+    }
+    result[key] = value
+  }
+  return result, nil
+}
+
+// mapEntries - returns the keys and values of a map, a struct (converted with ToMap) or a slice of key/value pairs
+func mapEntries(src any, dstType string) (keys []any, values []any, err zerror.Error) {
+  src = UnpackBaseElement(src, false) // removing pointers
+  elemValue := reflect.ValueOf(src)
+  switch elemValue.Kind() {
+  case reflect.Map:
+    for _, mapKey := range elemValue.MapKeys() {
+      keys = append(keys, mapKey.Interface())
+      values = append(values, elemValue.MapIndex(mapKey).Interface())
+    }
+    return keys, values, nil
+  case reflect.Struct:
+    structMap := map[string]any{}
+    err = ToMap(&structMap, DefaultParserConfig, src)
+    if err != nil {
+      return nil, nil, err
+    }
+    return mapEntries(structMap, dstType)
+  case reflect.Slice, reflect.Array:
+    for idx := 0; idx < elemValue.Len(); idx++ {
+      pair := UnpackBaseElement(elemValue.Index(idx).Interface(), false)
+      pairValue := reflect.ValueOf(pair)
+      if pairMap, ok := pair.(map[string]any); ok && len(pairMap) == 2 {
+        key, hasKey := pairMap["key"]
+        value, hasValue := pairMap["value"]
+        if hasKey && hasValue {
+          keys, values = append(keys, key), append(values, value)
+          continue
+        }
+      } else if (pairValue.Kind() == reflect.Slice || pairValue.Kind() == reflect.Array) && pairValue.Len() == 2 {
+        keys = append(keys, pairValue.Index(0).Interface())
+        values = append(values, pairValue.Index(1).Interface())
+        continue
+      }
+      return nil, nil, zerror.New(ErrorConvertorTypeNotSupported, map[string]any{
+        "index":    idx,
+        "src":      pair,
+        "dst_type": dstType,
+        "error":    "element is not a key/value pair",
+      })
+    }
+    return keys, values, nil
+  }
+  return nil, nil, zerror.New(ErrorConvertorTypeNotSupported, map[string]any{
+    "src":      src,
+    "src_type": elemValue.Kind().String(),
+    "dst_type": dstType,
+  })
+}
+
+// mapEntryError - wraps the error of a map entry in ErrorConvertorElementFailed with the key of the entry
+func mapEntryError(key any, dstType string, zer zerror.Error) zerror.Error {
+  err := zerror.New(ErrorConvertorElementFailed, map[string]any{
+    "key":      key,
+    "dst_type": dstType,
+  })
+  err.Add(zer.GetList())
+  return err
+}
+
+// duplicateKeyError - returns ErrorConvertorElementFailed for source keys converted to an existing key ("1" and "01" to int)
+// the result would depend on the map iteration order
+func duplicateKeyError(key any, dstType string) zerror.Error {
+  return zerror.New(ErrorConvertorElementFailed, map[string]any{
+    "key":      key,
+    "dst_type": dstType,
+    "error":    "duplicate key after conversion",
+  })
+}
+
 // SliceMapStringAny - tries to convert any to []map[string]any
-func SliceMapStringAny(src any) (dst []map[string]any, err zerror.Error) {
+func SliceMapStringAny(src any, opts ...ConvertOptions) (dst []map[string]any, err zerror.Error) {
   result := []map[string]any{}
   if src == nil {
     return result, nil
   }
+  opt := getConvertOptions(opts)
+  if res, found, zer := convertRegistered[[]map[string]any](src, opt.Converters); found { // custom registered converter
+    if zer != nil {
+      return result, zer
+    }
+    return res, nil
+  }
+  src, err = decodeString(src, "[]map[string]any", opt)
+  if err != nil {
+    return result, err
+  }
   // fast check to see the src type is same as dst to avoid fancy reflect operations
   if srcVal, ok := src.([]map[string]any); ok {
     return srcVal, nil
@@ -2814,7 +3345,7 @@ func SliceMapStringAny(src any) (dst []map[string]any, err zerror.Error) {
       elemKind := reflect.TypeOf(elem).Kind()
       switch elemKind {
       case reflect.Map, reflect.Struct:
-        res, err := MapStringAny(elem)
+        res, err := MapStringAny(elem, opt)
         if err != nil {
           return result, err
         }
@@ -2829,7 +3360,7 @@ func SliceMapStringAny(src any) (dst []map[string]any, err zerror.Error) {
     }
     return result, nil
   } else if srcKind == reflect.Map || srcKind == reflect.Struct {
-    res, err := MapStringAny(src)
+    res, err := MapStringAny(src, opt)
     if err != nil {
       return result, err
     }
@@ -2850,27 +3381,54 @@ func SliceMapStringAny(src any) (dst []map[string]any, err zerror.Error) {
 //	src
 //	   1 - string                - will try parse the string and returns appropriate value (see code for supported RFC and ISO formats)
 //	   1,2 - number              - (int, uint, float types) - will be converted to integers and assumes unix time and/or unixnano time
+//	                               a single number is in ConvertOptions.EpochUnit (seconds by default), numeric strings are also unix times if the EpochUnit is set
 //	   7 numbers                 - time.Date(year, time.Month(month), day, hour, min, sec, nsec, time.UTC)
 //	   7 numbers + location      - time.Date(year, time.Month(month), day, hour, min, sec, nsec, location), uses time.UTC if location is nil
 //	   time.Time                 - returns value as is
 //     time.Duration             - returns
 //	   other                     - will return an error
+//	   ConvertOptions            - optional last parameter, strings are parsed with ConvertOptions.TimeLayout if set,
+//	                               else with the TimeLayouts (DefaultTimeLayoutRegistry) in order and dateparse as a fallback
+//	                               inputs without a zone use ConvertOptions.Location and the results are converted to ConvertOptions.NormalizeLocation if set
+//	                               relative expressions ("now-15m", "today", "now/d", "start of month") are accepted if ConvertOptions.RelativeTime is set
 func Time(args ...any) (dst time.Time, err zerror.Error) {
   result := time.Time{}
+  opt := DefaultConvertOptions
+  if len(args) > 0 {
+    if argOpt, ok := args[len(args)-1].(ConvertOptions); ok { // conversion options sent as the last parameter
+      opt = argOpt
+      args = args[:len(args)-1]
+    }
+  }
+  if opt.NormalizeLocation != nil { // converts the result to the target location
+    location := opt.NormalizeLocation
+    opt.NormalizeLocation = nil
+    result, err = Time(append(append([]any{}, args...), opt)...)
+    if err != nil || result.IsZero() {
+      return result, err
+    }
+    return result.In(location), nil
+  }
   if len(args) == 0 {
     return result, nil
   } else if len(args) == 1 {
     if args[0] == nil {
       return result, nil
     }
+    if res, found, zer := convertRegistered[time.Time](args[0], opt.Converters); found { // custom registered converter
+      if zer != nil {
+        return result, zer
+      }
+      return res, nil
+    }
     if timeVal, ok := args[0].(time.Time); ok {
       return timeVal, nil
     }
     if timeVal, ok := args[0].(time.Duration); ok {
-      return time.Unix(0, timeVal.Nanoseconds()), nil
+      return unixToTime(0, timeVal.Nanoseconds(), opt), nil
     }
     if timeVal, ok := args[0].([]byte); ok {
-      return Time(string(timeVal))
+      return Time(string(timeVal), opt)
     }
     // checking other types
     elemKind := reflect.TypeOf(args[0]).Kind()
@@ -2886,7 +3444,7 @@ func Time(args ...any) (dst time.Time, err zerror.Error) {
         err.Add(zer.GetList())
         return result, err
       }
-      return Time(sliceParam...)
+      return Time(append(sliceParam, opt)...)
     case reflect.String: // date is sent as a string so we will try to parse it
       timeStr, zer := String(args[0])
       if zer != nil {
@@ -2897,50 +3455,23 @@ func Time(args ...any) (dst time.Time, err zerror.Error) {
         })
         err.Add(zer.GetList())
       }
-
-      // we try to match one of the supported time formats
-      result, er := time.Parse(time.RFC822, timeStr)
-      if er != nil {
-        result, er = time.Parse(time.RFC850, timeStr)
-        if er != nil {
-          result, er = time.Parse(time.RFC1123, timeStr)
-          if er != nil {
-            result, er = time.Parse(time.RFC822Z, timeStr)
-            if er != nil {
-              result, er = time.Parse(time.RFC3339, timeStr)
-              if er != nil {
-                result, er = time.Parse(time.RFC1123Z, timeStr)
-                if er != nil {
-                  result, er = time.Parse(time.RFC3339Nano, timeStr)
-                  if er != nil {
-                    result, er = time.Parse(TimeFormatISOTZ, timeStr) // ISO datetime format with Z timezone
-                    if er != nil {
-                      result, er = time.Parse(TimeFormatISOSTZ, timeStr) // ISO datetime format with spacer timezone
-                      if er != nil {
-                        result, er = time.Parse(TimeFormatISO, timeStr) // ISO datetime format with no timezone
-                        if er != nil {
-                          result, er = time.Parse(TimeFormatISODate, timeStr) // ISO date format
-                          if er != nil {
-                            return result, zerror.New(ErrorConvertorTypeNotSupported, map[string]any{
-                              "src":      timeStr,
-                              "src_type": elemKind.String(),
-                              "dst_type": "time",
-                              "error":    er.Error(),
-                            })
-                          }
-                        }
-                      }
-                    }
-                  }
-                }
-              }
-            }
-          }
+      if opt.RelativeTime { // relative expressions ("now-1h", "today", "start of month")
+        if relativeTime, ok := parseRelativeTime(timeStr, opt); ok {
+          return relativeTime, nil
         }
       }
-      return result, nil
+      if opt.EpochUnit != EpochDefault { // numeric strings are unix times in the epoch unit
+        if intVal, er := strconv.ParseInt(timeStr, 10, 64); er == nil {
+          return epochToTime(intVal, 0, opt), nil
+        }
+        if floatVal, er := strconv.ParseFloat(timeStr, 64); er == nil && !math.IsNaN(floatVal) && !math.IsInf(floatVal, 0) {
+          return Time(floatVal, opt)
+        }
+      }
+
+      return parseTime(timeStr, opt)
     case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-      unixTime, zer := Int64(args[0])
+      unixTimeVal, zer := Int64(args[0])
       if zer != nil {
         err = zerror.New(ErrorConvertorTypeNotSupported, map[string]any{
           "src":      args[0],
@@ -2950,8 +3481,8 @@ func Time(args ...any) (dst time.Time, err zerror.Error) {
         err.Add(zer.GetList())
         return result, err
       }
-      result = time.Unix(unixTime, 0)
-    case reflect.Float32, reflect.Float64: // time is in float format, int part is unixTime, decimals are unixNano, there will be some nanosecond errors because of some floating point operations
+      result = epochToTime(unixTimeVal, 0, opt)
+    case reflect.Float32, reflect.Float64: // time is in float format, int part is the unix time in the epoch unit, decimals are the fraction of the unit, there will be some nanosecond errors because of some floating point operations
       floatTime, zer := Float64(args[0])
       if zer != nil {
         err = zerror.New(ErrorConvertorTypeNotSupported, map[string]any{
@@ -2962,7 +3493,7 @@ func Time(args ...any) (dst time.Time, err zerror.Error) {
         err.Add(zer.GetList())
         return result, err
       }
-      unixTime, zer := Int64(args[0])
+      unixTimeVal, zer := Int64(args[0])
       if zer != nil {
         err = zerror.New(ErrorConvertorTypeNotSupported, map[string]any{
           "src":      args[0],
@@ -2973,8 +3504,7 @@ func Time(args ...any) (dst time.Time, err zerror.Error) {
 
         return result, err
       }
-      unixNano := int64((floatTime - float64(unixTime)) * 1e9)
-      result = time.Unix(unixTime, unixNano)
+      result = epochToTime(unixTimeVal, floatTime-float64(unixTimeVal), opt)
     default:
       err = zerror.New(ErrorConvertorTypeNotSupported, map[string]any{
         "src":      args[0],
@@ -2984,7 +3514,7 @@ func Time(args ...any) (dst time.Time, err zerror.Error) {
       return result, err
     }
   } else if len(args) == 2 { // assuming 2 integers for unixTime and unixNano
-    unixTime, err := Int64(args[0])
+    unixTimeVal, err := Int64(args[0])
     if err != nil {
       return result, err
     }
@@ -2992,7 +3522,7 @@ func Time(args ...any) (dst time.Time, err zerror.Error) {
     if err != nil {
       return result, err
     }
-    result = time.Unix(unixTime, unixNano)
+    result = unixToTime(unixTimeVal, unixNano, opt)
   } else if len(args) == 7 { // assuming 7 integers, no location, will default location to UTC
     year, err := Int(args[0])
     if err != nil {
@@ -3022,7 +3552,11 @@ func Time(args ...any) (dst time.Time, err zerror.Error) {
     if err != nil {
       return result, err
     }
-    result = time.Date(year, time.Month(month), day, hour, min, sec, nsec, time.UTC)
+    location := time.UTC
+    if opt.Location != nil {
+      location = opt.Location
+    }
+    result = time.Date(year, time.Month(month), day, hour, min, sec, nsec, location)
   } else if len(args) == 8 { // assuming 7 integers and location, if location is nil it will use time.UTC
     year, err := Int(args[0])
     if err != nil {
@@ -3069,3 +3603,568 @@ func Time(args ...any) (dst time.Time, err zerror.Error) {
 
   return result, nil
 }
+
+// unixToTime - returns the unix time in ConvertOptions.Location, time.Local if not set
+func unixToTime(sec int64, nsec int64, opt ConvertOptions) time.Time {
+  result := time.Unix(sec, nsec)
+  if opt.Location != nil {
+    return result.In(opt.Location)
+  }
+  return result
+}
+
+// epochToTime - converts a unix time in the ConvertOptions.EpochUnit (value is the integer part, fraction the fractional part of the unit) to time
+func epochToTime(value int64, fraction float64, opt ConvertOptions) time.Time {
+  perSecond := int64(1)
+  switch opt.EpochUnit {
+  case EpochExcel1900, EpochExcel1904, EpochJulianDay, EpochModifiedJulianDay:
+    return serialDayToTime(float64(value)+fraction, opt)
+  case EpochMillis:
+    perSecond = 1e3
+  case EpochMicros:
+    perSecond = 1e6
+  case EpochNanos:
+    perSecond = 1e9
+  case EpochAuto: // detected by magnitude, seconds up to 1e11 (year 5138), millis up to 1e14, micros up to 1e17 and nanos above
+    magnitude := value
+    if magnitude < 0 {
+      magnitude = -magnitude
+    }
+    switch {
+    case magnitude >= 1e17:
+      perSecond = 1e9
+    case magnitude >= 1e14:
+      perSecond = 1e6
+    case magnitude >= 1e11:
+      perSecond = 1e3
+    }
+  }
+  nanosPerUnit := 1e9 / perSecond
+  return unixToTime(value/perSecond, (value%perSecond)*nanosPerUnit+int64(fraction*float64(nanosPerUnit)), opt)
+}
+
+// serial day systems, Julian and Modified Julian days of the unix epoch
+const (
+  unixJulianDay         = 2440587.5 // Julian day of 1970-01-01 00:00 UTC
+  unixModifiedJulianDay = 40587     // Modified Julian day of 1970-01-01 00:00 UTC
+  secondsPerDay         = 86400
+)
+
+// serialDayToTime - converts a spreadsheet serial date or a Julian day to time
+// spreadsheet dates are wall clock dates in ConvertOptions.Location (UTC if not set), Julian days are UTC instants converted to ConvertOptions.Location
+// the time of day is rounded to the millisecond to remove the floating point errors
+func serialDayToTime(days float64, opt ConvertOptions) time.Time {
+  location := time.UTC
+  if opt.Location != nil {
+    location = opt.Location
+  }
+  wholeDays := math.Floor(days)
+  dayTime := time.Duration(math.Round((days-wholeDays)*secondsPerDay*1e3)) * time.Millisecond
+
+  switch opt.EpochUnit {
+  case EpochExcel1900:
+    base := time.Date(1899, 12, 30, 0, 0, 0, 0, location)
+    if wholeDays < 61 { // the 1900 system counts the non existent 1900-02-29 (serial 60), the dates before it are shifted by one day
+      base = base.AddDate(0, 0, 1)
+    }
+    return base.AddDate(0, 0, int(wholeDays)).Add(dayTime)
+  case EpochExcel1904:
+    return time.Date(1904, 1, 1, 0, 0, 0, 0, location).AddDate(0, 0, int(wholeDays)).Add(dayTime)
+  case EpochJulianDay:
+    days -= unixJulianDay
+  case EpochModifiedJulianDay:
+    days -= unixModifiedJulianDay
+  }
+  return time.Unix(0, 0).Add(time.Duration(math.Round(days*secondsPerDay*1e3)) * time.Millisecond).In(location)
+}
+
+// ToSerialDate - converts any time accepted by Time to a spreadsheet serial date or a Julian day number, the reverse of the Time conversion with the same EpochUnit
+// unit must be EpochExcel1900, EpochExcel1904, EpochJulianDay or EpochModifiedJulianDay
+// spreadsheet dates use the wall clock of the time (in ConvertOptions.NormalizeLocation if set)
+// example:
+//
+//	serial, err := ToSerialDate("2023-07-16 12:00:00", EpochExcel1900) // 45123.5
+func ToSerialDate(src any, unit EpochUnit, opts ...ConvertOptions) (dst float64, err zerror.Error) {
+  opt := getConvertOptions(opts)
+  timeVal, err := Time(src, opt)
+  if err != nil {
+    return 0, err
+  }
+
+  switch unit {
+  case EpochExcel1900, EpochExcel1904:
+    year, month, day := timeVal.Date()
+    wallDate := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+    base := time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+    if unit == EpochExcel1904 {
+      base = time.Date(1904, 1, 1, 0, 0, 0, 0, time.UTC)
+    } else if wallDate.Before(time.Date(1900, 3, 1, 0, 0, 0, 0, time.UTC)) {
+      base = base.AddDate(0, 0, 1)
+    }
+    days := math.Round(wallDate.Sub(base).Hours() / 24)
+    dayTime := time.Duration(timeVal.Hour())*time.Hour + time.Duration(timeVal.Minute())*time.Minute +
+      time.Duration(timeVal.Second())*time.Second + time.Duration(timeVal.Nanosecond())
+    return days + dayTime.Seconds()/secondsPerDay, nil
+  case EpochJulianDay, EpochModifiedJulianDay:
+    days := float64(timeVal.Unix())/secondsPerDay + float64(timeVal.Nanosecond())/1e9/secondsPerDay
+    if unit == EpochJulianDay {
+      return days + unixJulianDay, nil
+    }
+    return days + unixModifiedJulianDay, nil
+  default:
+    return 0, zerror.New(ErrorConvertorTypeNotSupported, map[string]any{
+      "src":        src,
+      "dst_type":   "serial date",
+      "epoch_unit": unit,
+    })
+  }
+}
+
+// parseTime - parses the string using the exact layout, the layout registry and dateparse as configured in the options
+func parseTime(timeStr string, opt ConvertOptions) (dst time.Time, err zerror.Error) {
+  location := time.UTC
+  if opt.Location != nil {
+    location = opt.Location
+  }
+  if opt.TimeLayout != "" {
+    layout, err := TranslateLayout(opt.TimeLayout, opt.TimeLayoutStyle)
+    if err != nil {
+      return time.Time{}, err
+    }
+    result, er := time.ParseInLocation(layout, timeStr, location)
+    if er != nil {
+      return result, zerror.New(ErrorConvertorTypeNotSupported, map[string]any{
+        "src":      timeStr,
+        "src_type": "string",
+        "dst_type": "time",
+        "layout":   opt.TimeLayout,
+        "error":    er.Error(),
+      })
+    }
+    return result, nil
+  }
+
+  registry := opt.TimeLayouts
+  if registry == nil {
+    registry = DefaultTimeLayoutRegistry
+  }
+  for _, layout := range registry.Layouts() {
+    if result, er := time.ParseInLocation(layout, timeStr, location); er == nil {
+      return result, nil
+    }
+  }
+  errorDetails := map[string]any{
+    "src":      timeStr,
+    "src_type": "string",
+    "dst_type": "time",
+  }
+  if !opt.DisableDateparse {
+    result, er := dateparse.ParseIn(timeStr, location)
+    if er == nil {
+      return result, nil
+    }
+    errorDetails["error"] = er.Error()
+  }
+
+  return time.Time{}, zerror.New(ErrorConvertorTypeNotSupported, errorDetails)
+}
+
+// Duration - tries to convert any to time.Duration
+// params:
+//
+//	src
+//	   time.Duration          - returns value as is
+//	   string                 - Go durations ("1h30m", "250ms"), ISO-8601 durations ("PT1H30M", "P2D", "-P1W") or numbers
+//	   number                 - (int, uint, float, decimal types and numeric strings) multiplied by ConvertOptions.DurationUnit (nanoseconds if not set)
+//	   other                  - will return an error
+//
+// ISO-8601 years and months have no fixed length and are not supported, weeks are 7 days and days are 24 hours
+func Duration(src any, opts ...ConvertOptions) (dst time.Duration, err zerror.Error) {
+  if src == nil {
+    return 0, nil
+  }
+  opt := getConvertOptions(opts)
+  if res, found, zer := convertRegistered[time.Duration](src, opt.Converters); found { // custom registered converter
+    if zer != nil {
+      return 0, zer
+    }
+    return res, nil
+  }
+  src = jsonNumberValue(src)
+  unit := opt.DurationUnit
+  if unit <= 0 {
+    unit = time.Nanosecond
+  }
+
+  switch val := src.(type) {
+  case time.Duration:
+    return val, nil
+  case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, *big.Int: // *big.Int for the integer literals outside of the 64-bit range
+    intVal, err := Int64(val, opt)
+    if err != nil {
+      return 0, err
+    }
+    if numberOutOfRange(val, math.MinInt64, math.MaxInt64) != 0 || intVal > math.MaxInt64/int64(unit) || intVal < math.MinInt64/int64(unit) {
+      return 0, zerror.New(ErrorConvertorNumberOverflow, map[string]any{
+        "from_type": reflect.TypeOf(src).String(),
+        "to_type":   "time.Duration",
+        "value":     src,
+      })
+    }
+    return time.Duration(intVal) * unit, nil
+  case float32, float64, decimal.Decimal:
+    floatVal, err := Float64(val, opt)
+    if err != nil {
+      return 0, err
+    }
+    floatVal = floatVal * float64(unit)
+    if math.IsNaN(floatVal) || floatVal >= math.MaxInt64 || floatVal < math.MinInt64 {
+      return 0, zerror.New(ErrorConvertorNumberOverflow, map[string]any{
+        "from_type": reflect.TypeOf(src).String(),
+        "to_type":   "time.Duration",
+        "value":     src,
+      })
+    }
+    if opt.Strict && hasFraction(floatVal) {
+      return 0, strictPrecisionLossError(src, "time.Duration")
+    }
+    return time.Duration(floatVal), nil
+  case []byte:
+    return Duration(string(val), opt)
+  case string:
+    val = strings.TrimSpace(val)
+    if duration, er := time.ParseDuration(val); er == nil {
+      return duration, nil
+    }
+    duration, zer := parseISODuration(val)
+    if zer == nil {
+      return duration, nil
+    }
+    if zer.Has(ErrorConvertorNumberOverflow) { // ISO-8601 durations outside of the range
+      return 0, zer
+    }
+    if formatted, er := applyNumberFormat(val, opt.NumberFormat, "time.Duration"); er == nil {
+      if literal, ok := parseIntegerLiteral(formatted); ok { // numeric strings use the duration unit
+        return Duration(literal, opt)
+      }
+    }
+    if floatVal, er := Float64(val, opt); er == nil {
+      return Duration(floatVal, opt)
+    }
+    return 0, zerror.New(ErrorConvertorTypeNotSupported, map[string]any{
+      "src":      val,
+      "src_type": "string",
+      "dst_type": "time.Duration",
+    })
+  default:
+    return 0, zerror.New(ErrorConvertorTypeNotSupported, map[string]any{
+      "src":      val,
+      "src_type": reflect.TypeOf(val).String(),
+      "dst_type": "time.Duration",
+    })
+  }
+}
+
+// parseISODuration - parses ISO-8601 durations with week, day, hour, minute and second designators ("P1W", "P2DT3H", "-PT1.5S")
+// the fractions use "." or "," and are accepted on any designator
+// returns ErrorConvertorInvalidSyntax if val is not an ISO-8601 duration and ErrorConvertorNumberOverflow if it is outside of the time.Duration range
+func parseISODuration(val string) (dst time.Duration, err zerror.Error) {
+  src := val
+  invalid := strictInvalidSyntaxError(src, "time.Duration", errors.New("invalid ISO-8601 duration"))
+  negative := false
+  if strings.HasPrefix(val, "-") || strings.HasPrefix(val, "+") {
+    negative = val[0] == '-'
+    val = val[1:]
+  }
+  if len(val) < 3 || (val[0] != 'P' && val[0] != 'p') {
+    return 0, invalid
+  }
+  val = strings.ToUpper(val[1:])
+
+  units := map[byte]time.Duration{'W': 7 * 24 * time.Hour, 'D': 24 * time.Hour}
+  timeUnits := map[byte]time.Duration{'H': time.Hour, 'M': time.Minute, 'S': time.Second}
+  total := 0.0
+  number := ""
+  inTime := false
+  found := false
+  for idx := 0; idx < len(val); idx++ {
+    char := val[idx]
+    switch {
+    case char >= '0' && char <= '9':
+      number += string(char)
+    case char == '.' || char == ',':
+      number += "."
+    case char == 'T':
+      if inTime || number != "" {
+        return 0, invalid
+      }
+      inTime = true
+    default:
+      unit, exists := units[char]
+      if inTime {
+        unit, exists = timeUnits[char]
+      }
+      if !exists || number == "" {
+        return 0, invalid
+      }
+      amount, er := strconv.ParseFloat(number, 64)
+      if er != nil && !errors.Is(er, strconv.ErrRange) { // out of range amounts are infinite, reported as an overflow below
+        return 0, invalid
+      }
+      total += amount * float64(unit)
+      number = ""
+      found = true
+    }
+  }
+  if !found || number != "" {
+    return 0, invalid
+  }
+  if total >= math.MaxInt64 {
+    return 0, zerror.New(ErrorConvertorNumberOverflow, map[string]any{
+      "from_type": "string",
+      "to_type":   "time.Duration",
+      "value":     src,
+    })
+  }
+  if negative {
+    total = -total
+  }
+  return time.Duration(math.Round(total)), nil
+}
+
+// ToByteSize - tries to convert any to ByteSize
+// params:
+//
+//	src
+//	   ByteSize               - returns value as is
+//	   string                 - number with an optional SI (kB, MB, GB, TB, PB, EB) or IEC (KiB, MiB, GiB, TiB, PiB, EiB) suffix, case insensitive ("512KiB", "1.5 gb", "100")
+//	   number                 - (int, uint, float, decimal types) size in bytes
+//	   other                  - will return an error
+//
+// fractional bytes follow the integer conversion options (ConvertOptions.Rounding, ConvertOptions.Strict), sizes outside the uint64 range return an overflow error
+// or are saturated with ConvertOptions.Overflow
+func ToByteSize(src any, opts ...ConvertOptions) (dst ByteSize, err zerror.Error) {
+  if src == nil {
+    return 0, nil
+  }
+  opt := getConvertOptions(opts)
+  if res, found, zer := convertRegistered[ByteSize](src, opt.Converters); found { // custom registered converter
+    if zer != nil {
+      return 0, zer
+    }
+    return res, nil
+  }
+
+  size := decimal.Zero
+  switch val := src.(type) {
+  case ByteSize:
+    return val, nil
+  case bool:
+    return 0, zerror.New(ErrorConvertorTypeNotSupported, map[string]any{
+      "src":      val,
+      "src_type": "bool",
+      "dst_type": "ByteSize",
+    })
+  case []byte:
+    return ToByteSize(string(val), opt)
+  case string:
+    number := strings.TrimSpace(val)
+    unitStart := strings.LastIndexFunc(number, func(r rune) bool { return r >= '0' && r <= '9' || r == '.' || r == ',' }) + 1
+    multiplier, ok := byteSizeUnits[strings.ToLower(strings.TrimSpace(number[unitStart:]))]
+    if !ok {
+      return 0, zerror.New(ErrorConvertorTypeNotSupported, map[string]any{
+        "src":      val,
+        "src_type": "string",
+        "dst_type": "ByteSize",
+      })
+    }
+    size, err = Decimal(strings.TrimSpace(number[:unitStart]), opt)
+    if err != nil {
+      return 0, err
+    }
+    size = size.Mul(decimal.NewFromBigInt(new(big.Int).SetUint64(uint64(multiplier)), 0))
+  default:
+    size, err = Decimal(val, opt)
+    if err != nil {
+      return 0, err
+    }
+  }
+
+  if opt.Rounding != RoundTruncate {
+    size = roundDecimal(size, 0, opt.Rounding)
+  }
+  if opt.Strict && !size.IsInteger() {
+    return 0, strictPrecisionLossError(src, "ByteSize")
+  }
+  size = size.Truncate(0)
+  if size.Sign() < 0 || !size.BigInt().IsUint64() {
+    if opt.Overflow == OverflowSaturate {
+      if size.Sign() < 0 {
+        return 0, nil
+      }
+      return ByteSize(math.MaxUint64), nil
+    }
+    return 0, zerror.New(ErrorConvertorNumberOverflow, map[string]any{
+      "from_type": reflect.TypeOf(src).String(),
+      "to_type":   "ByteSize",
+      "value":     src,
+    })
+  }
+  return ByteSize(size.BigInt().Uint64()), nil
+}
+
+// byteSizeUnits - ToByteSize suffixes (lower case) and their size
+var byteSizeUnits = map[string]ByteSize{
+  "": Byte, "b": Byte, "byte": Byte, "bytes": Byte,
+  "kb": KB, "mb": MB, "gb": GB, "tb": TB, "pb": PB, "eb": EB,
+  "kib": KiB, "mib": MiB, "gib": GiB, "tib": TiB, "pib": PiB, "eib": EiB,
+}
+
+// UUID - tries to convert any to uuid.UUID
+// accepts the canonical, braced, urn and plain hex string forms, [16]byte, []byte (16 raw bytes or the text forms) and null uuids
+// an invalid null uuid converts to uuid.Nil
+func UUID(src any, opts ...ConvertOptions) (dst uuid.UUID, err zerror.Error) {
+  if src == nil {
+    return uuid.Nil, nil
+  }
+  opt := getConvertOptions(opts)
+  if res, found, zer := convertRegistered[uuid.UUID](src, opt.Converters); found { // custom registered converter
+    if zer != nil {
+      return uuid.Nil, zer
+    }
+    return res, nil
+  }
+  switch val := src.(type) {
+  case uuid.UUID:
+    return val, nil
+  case [16]byte:
+    return uuid.UUID(val), nil
+  case uuid.NullUUID:
+    return val.UUID, nil
+  case NullUUID:
+    return val.UUID, nil
+  case []byte:
+    if len(val) == uuid.Size {
+      return uuid.FromBytesOrNil(val), nil
+    }
+    return UUID(string(val), opt)
+  case string:
+    dst, er := uuid.FromString(strings.TrimSpace(val))
+    if er != nil {
+      return uuid.Nil, zerror.New(ErrorConvertorTypeNotSupported, map[string]any{
+        "error":    er.Error(),
+        "src":      val,
+        "src_type": "string",
+        "dst_type": "uuid.UUID",
+      })
+    }
+    return dst, nil
+  default:
+    return uuid.Nil, zerror.New(ErrorConvertorTypeNotSupported, map[string]any{
+      "src":      val,
+      "src_type": reflect.TypeOf(val).String(),
+      "dst_type": "uuid.UUID",
+    })
+  }
+}
+
+// To - generic conversion entry point, converts src to the type T using the matching converter
+// (Int, String, Decimal, Time, SliceString, etc.)
+// named types, structs and any other type not covered by a converter are filled using SetFieldValueByType and DefaultParserConfig
+// example:
+//
+//	num, err := To[int]("42")                   // 42
+//	dur, err := To[time.Duration](int64(1e9))   // 1s
+//	cfg, err := To[MyConfig](map[string]any{...})
+func To[T any](src any, opts ...ConvertOptions) (dst T, err zerror.Error) {
+  var result any
+  switch any(dst).(type) {
+  case int:
+    result, err = Int(src, opts...)
+  case int8:
+    result, err = Int8(src, opts...)
+  case int16:
+    result, err = Int16(src, opts...)
+  case int32:
+    result, err = Int32(src, opts...)
+  case int64:
+    result, err = Int64(src, opts...)
+  case uint:
+    result, err = Uint(src, opts...)
+  case uint8:
+    result, err = Uint8(src, opts...)
+  case uint16:
+    result, err = Uint16(src, opts...)
+  case uint32:
+    result, err = Uint32(src, opts...)
+  case uint64:
+    result, err = Uint64(src, opts...)
+  case float32:
+    result, err = Float32(src, opts...)
+  case float64:
+    result, err = Float64(src, opts...)
+  case complex64:
+    result, err = Complex64(src, opts...)
+  case complex128:
+    result, err = Complex128(src, opts...)
+  case string:
+    result, err = StringWithOptions(src, getConvertOptions(opts))
+  case bool:
+    result, err = Bool(src, opts...)
+  case time.Time:
+    result, err = Time(src, getConvertOptions(opts))
+  case time.Duration:
+    result, err = Duration(src, opts...)
+  case ByteSize:
+    result, err = ToByteSize(src, opts...)
+  case decimal.Decimal:
+    result, err = Decimal(src, opts...)
+  case *big.Int:
+    result, err = BigInt(src, opts...)
+  case *big.Float:
+    result, err = BigFloat(src, opts...)
+  case *big.Rat:
+    result, err = BigRat(src, opts...)
+  case uuid.UUID:
+    result, err = UUID(src, opts...)
+  case net.IP:
+    result, err = IP(src, opts...)
+  case netip.Addr:
+    result, err = IPAddr(src, opts...)
+  case netip.Prefix:
+    result, err = IPPrefix(src, opts...)
+  case net.HardwareAddr:
+    result, err = HardwareAddr(src, opts...)
+  case *url.URL:
+    result, err = URL(src, opts...)
+  case map[string]any:
+    result, err = MapStringAny(src, opts...)
+  case []any:
+    result, err = SliceAny(src, opts...)
+  case []byte:
+    result, err = SliceByte(src, opts...)
+  case []string:
+    result, err = SliceString(src, opts...)
+  case []int:
+    result, err = SliceInt(src, opts...)
+  case []map[string]any:
+    result, err = SliceMapStringAny(src, opts...)
+  default: // named types, structs and other types are set through reflection
+    if src == nil {
+      return dst, nil
+    }
+    parseSettings := DefaultParserConfig
+    parseSettings.ConvertOptions = getConvertOptions(opts)
+    err = SetFieldValueByType(parseSettings, reflect.ValueOf(&dst).Elem(), src)
+    if err != nil {
+      var empty T
+      return empty, err
+    }
+    return dst, nil
+  }
+  if err != nil {
+    return dst, err
+  }
+
+  return result.(T), nil
+}
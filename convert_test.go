@@ -32,6 +32,8 @@ func TestUnit_Bool(t *testing.T) {
     {name: "uint8 true", input: uint8(5), expect: true},
     {name: "uint16 true", input: uint16(5), expect: true},
     {name: "uint64 true", input: uint64(5), expect: true},
+    {name: "int64 above 2^53", input: int64(1 << 60), expect: true},
+    {name: "uint64 max", input: uint64(math.MaxUint64), expect: true},
 
     // Time duration
     {name: "time.Duration true", input: time.Duration(5), expect: true},
@@ -48,8 +50,17 @@ func TestUnit_Bool(t *testing.T) {
     // Byte slice and string tests
     {name: "[]byte true", input: []byte{49, 48, 46, 48, 48, 48, 48, 48, 48, 48, 48}, expect: true},
     {name: "[]byte false", input: []byte("false"), expect: false},
-    {name: "string true", input: "5", expect: false},
+    {name: "string true", input: "5", expect: true},
     {name: "string false", input: "false", expect: false},
+    {name: "string yes", input: " Yes ", expect: true},
+    {name: "string off", input: "OFF", expect: false},
+    {name: "[]byte y", input: []byte("y"), expect: true},
+    {name: "[]byte no", input: []byte("no"), expect: false},
+    {name: "empty string", input: "", expect: false},
+    {name: "empty []byte", input: []byte{}, expect: false},
+    {name: "unknown word", input: "banana", hasError: true, errorCode: ErrorConvertorInvalidSyntax},
+    {name: "unknown []byte word", input: []byte("banana"), hasError: true, errorCode: ErrorConvertorInvalidSyntax},
+    {name: "nan string", input: "NaN", hasError: true, errorCode: ErrorConvertorNumberOverflow},
 
     // Error case
     {name: "unsupported type", input: []string{}, hasError: true, errorCode: ErrorConvertorTypeNotSupported},
@@ -1121,6 +1132,7 @@ func TestUnit_Decimal(t *testing.T) {
     {name: "uint16", input: uint16(5), setup: func() decimal.Decimal { return decimal.NewFromInt(5) }},
     {name: "uint32", input: uint32(5), setup: func() decimal.Decimal { return decimal.NewFromInt(5) }},
     {name: "uint64", input: uint64(5), setup: func() decimal.Decimal { return decimal.NewFromInt(5) }},
+    {name: "uint64 max", input: uint64(math.MaxUint64), setup: func() decimal.Decimal { return decimal.RequireFromString("18446744073709551615") }},
 
     // Complex tests
     {name: "complex64 real part", input: complex64(5 + 12i), setup: func() decimal.Decimal { return decimal.NewFromInt(5) }},
@@ -1139,6 +1151,9 @@ func TestUnit_Decimal(t *testing.T) {
     {name: "float64 scientific negative", input: 1.5134e-02, setup: func() decimal.Decimal { return decimal.NewFromFloat(1.5134e-02) }},
     {name: "float64 max", input: math.MaxFloat64, setup: func() decimal.Decimal { d, _ := decimal.NewFromString("1.7976931348623157e+308"); return d }},
     {name: "float64 smallest non-zero", input: math.SmallestNonzeroFloat64, setup: func() decimal.Decimal { d, _ := decimal.NewFromString("5e-324"); return d }},
+    {name: "float64 NaN", input: math.NaN(), hasError: true, errorCode: ErrorConvertorNumberOverflow},
+    {name: "float32 infinite", input: float32(math.Inf(-1)), hasError: true, errorCode: ErrorConvertorNumberOverflow},
+    {name: "complex128 infinite real part", input: complex(math.Inf(1), 0), hasError: true, errorCode: ErrorConvertorNumberOverflow},
 
     // String and byte slice tests
     {name: "string decimal", input: "5.5", setup: func() decimal.Decimal { d, _ := decimal.NewFromString("5.5"); return d }},
@@ -1185,6 +1200,77 @@ func TestUnit_Decimal(t *testing.T) {
   }
 }
 
+func TestUnit_DecimalOptions(t *testing.T) {
+  scale := func(places int32) *int32 { return &places }
+  tenth, fifth := 0.1, 0.2 // variables, constant arithmetic would be exact
+
+  tests := []struct {
+    name      string
+    input     any
+    opt       ConvertOptions
+    expect    string
+    errorCode string
+  }{
+    {name: "shortest float", input: tenth + fifth, expect: "0.30000000000000004"},
+    {name: "scale truncates by default", input: tenth + fifth, opt: ConvertOptions{DecimalScale: scale(2)}, expect: "0.3"},
+    {name: "scale half even", input: "2.345", opt: ConvertOptions{DecimalScale: scale(2), Rounding: RoundHalfEven}, expect: "2.34"},
+    {name: "scale half up", input: "2.345", opt: ConvertOptions{DecimalScale: scale(2), Rounding: RoundHalfUp}, expect: "2.35"},
+    {name: "scale half up negative", input: -2.345, opt: ConvertOptions{DecimalScale: scale(2), Rounding: RoundHalfUp}, expect: "-2.35"},
+    {name: "scale floor", input: -1.231, opt: ConvertOptions{DecimalScale: scale(2), Rounding: RoundFloor}, expect: "-1.24"},
+    {name: "scale ceil", input: 1.231, opt: ConvertOptions{DecimalScale: scale(2), Rounding: RoundCeil}, expect: "1.24"},
+    {name: "scale zero", input: "19.99", opt: ConvertOptions{DecimalScale: scale(0), Rounding: RoundHalfUp}, expect: "20"},
+    {name: "negative scale", input: 1250, opt: ConvertOptions{DecimalScale: scale(-2), Rounding: RoundHalfEven}, expect: "1200"},
+    {name: "scale on decimal source", input: decimal.RequireFromString("1.005"), opt: ConvertOptions{DecimalScale: scale(2), Rounding: RoundHalfUp}, expect: "1.01"},
+    {name: "exact float", input: 0.1, opt: ConvertOptions{DecimalExactFloat: true}, expect: "0.1000000000000000055511151231257827021181583404541015625"},
+    {name: "exact float32", input: float32(0.1), opt: ConvertOptions{DecimalExactFloat: true}, expect: "0.100000001490116119384765625"},
+    {name: "exact float with scale", input: 1.005, opt: ConvertOptions{DecimalExactFloat: true, DecimalScale: scale(2), Rounding: RoundHalfUp}, expect: "1"},
+    {name: "shortest float with scale", input: 1.005, opt: ConvertOptions{DecimalScale: scale(2), Rounding: RoundHalfUp}, expect: "1.01"},
+    {name: "strict exact scale", input: "1.50", opt: ConvertOptions{DecimalScale: scale(2), Strict: true}, expect: "1.5"},
+    {name: "strict rounding", input: "1.505", opt: ConvertOptions{DecimalScale: scale(2), Strict: true}, errorCode: ErrorConvertorPrecisionLoss},
+    {name: "NaN with scale", input: math.NaN(), opt: ConvertOptions{DecimalScale: scale(2)}, errorCode: ErrorConvertorNumberOverflow},
+  }
+
+  for _, tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      res, err := Decimal(tt.input, tt.opt)
+      if tt.errorCode != "" {
+        if assert.NotNil(t, err) {
+          assert.True(t, err.Has(tt.errorCode), "Expected error code '%s' but got '%s'", tt.errorCode, err.Error())
+        }
+        return
+      }
+      assert.Nil(t, err)
+      assert.Equal(t, tt.expect, res.String())
+    })
+  }
+
+  t.Run("String", func(t *testing.T) {
+    res, err := StringWithOptions(decimal.RequireFromString("1.5"), ConvertOptions{DecimalScale: scale(2)})
+    assert.Nil(t, err)
+    assert.Equal(t, "1.50", res)
+
+    res, err = StringWithOptions(decimal.RequireFromString("2.675"), ConvertOptions{DecimalScale: scale(2), Rounding: RoundHalfEven})
+    assert.Nil(t, err)
+    assert.Equal(t, "2.68", res)
+  })
+
+  t.Run("ToStruct", func(t *testing.T) {
+    dst := struct {
+      Price decimal.Decimal `json:"price"`
+      Total decimal.Decimal `json:"total"`
+      Label string          `json:"label"`
+    }{}
+    config := DefaultParserConfig
+    config.DecimalScale = scale(2)
+    config.Rounding = RoundHalfEven
+    err := ToStruct(&dst, config, map[string]any{"price": tenth + fifth, "total": decimal.RequireFromString("10.125"), "label": decimal.RequireFromString("3")})
+    assert.Nil(t, err)
+    assert.Equal(t, "0.3", dst.Price.String())
+    assert.Equal(t, "10.12", dst.Total.String())
+    assert.Equal(t, "3.00", dst.Label)
+  })
+}
+
 func TestUnit_Complex64(t *testing.T) {
   tests := []struct {
     name      string
@@ -1243,7 +1329,7 @@ func TestUnit_Complex64(t *testing.T) {
     // Error cases
     {name: "nil value", input: nil, expect: 0},
     {name: "unsupported type - struct", input: struct{}{}, hasError: true, errorCode: ErrorConvertorTypeNotSupported},
-    {name: "unsupported type - slice", input: []string{"1", "2"}, hasError: true, errorCode: ErrorConvertorTypeNotSupported},
+    {name: "unsupported type - slice", input: []string{"1", "2", "3"}, hasError: true, errorCode: ErrorConvertorTypeNotSupported},
     {name: "unsupported type - map", input: map[string]int{"a": 1}, hasError: true, errorCode: ErrorConvertorTypeNotSupported},
   }
 
@@ -1326,7 +1412,7 @@ func TestUnit_Complex128(t *testing.T) {
     // Error cases
     {name: "nil value", input: nil, expect: 0},
     {name: "unsupported type - struct", input: struct{}{}, hasError: true, errorCode: ErrorConvertorTypeNotSupported},
-    {name: "unsupported type - slice", input: []string{"1", "2"}, hasError: true, errorCode: ErrorConvertorTypeNotSupported},
+    {name: "unsupported type - slice", input: []string{"1", "2", "3"}, hasError: true, errorCode: ErrorConvertorTypeNotSupported},
     {name: "unsupported type - map", input: map[string]int{"a": 1}, hasError: true, errorCode: ErrorConvertorTypeNotSupported},
   }
 
@@ -2119,3 +2205,792 @@ func TestUnit_Time(t *testing.T) {
 //   }
 //   assert.Equal(t, res.UTC().Format(time.RFC3339), currTime.Format(time.RFC3339)) // testing only up to seconds
 // }
+
+func TestUnit_To(t *testing.T) {
+  type toNamedInt int
+  type toStruct struct {
+    Name  string `json:"name"`
+    Count int    `json:"count"`
+  }
+
+  t.Run("int from string", func(t *testing.T) {
+    res, err := To[int]("42")
+    assert.Nil(t, err)
+    assert.Equal(t, 42, res)
+  })
+  t.Run("uint8 overflow", func(t *testing.T) {
+    _, err := To[uint8](300)
+    if assert.NotNil(t, err) {
+      assert.True(t, err.Has(ErrorConvertorNumberOverflow))
+    }
+  })
+  t.Run("float64 from string", func(t *testing.T) {
+    res, err := To[float64]("3.5")
+    assert.Nil(t, err)
+    assert.Equal(t, 3.5, res)
+  })
+  t.Run("string from int", func(t *testing.T) {
+    res, err := To[string](15)
+    assert.Nil(t, err)
+    assert.Equal(t, "15", res)
+  })
+  t.Run("bool from string", func(t *testing.T) {
+    res, err := To[bool]("true")
+    assert.Nil(t, err)
+    assert.True(t, res)
+  })
+  t.Run("decimal from string", func(t *testing.T) {
+    res, err := To[decimal.Decimal]("12.34")
+    assert.Nil(t, err)
+    assert.True(t, decimal.RequireFromString("12.34").Equal(res))
+  })
+  t.Run("time from string", func(t *testing.T) {
+    res, err := To[time.Time]("2023-01-02")
+    assert.Nil(t, err)
+    assert.Equal(t, time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC), res)
+  })
+  t.Run("slice string from slice any", func(t *testing.T) {
+    res, err := To[[]string]([]any{"a", 1})
+    assert.Nil(t, err)
+    assert.Equal(t, []string{"a", "1"}, res)
+  })
+  t.Run("map string any from map", func(t *testing.T) {
+    res, err := To[map[string]any](map[string]int{"a": 1})
+    assert.Nil(t, err)
+    assert.Equal(t, map[string]any{"a": 1}, res)
+  })
+  t.Run("duration from int64", func(t *testing.T) {
+    res, err := To[time.Duration](int64(time.Second))
+    assert.Nil(t, err)
+    assert.Equal(t, time.Second, res)
+  })
+  t.Run("named type", func(t *testing.T) {
+    res, err := To[toNamedInt]("7")
+    assert.Nil(t, err)
+    assert.Equal(t, toNamedInt(7), res)
+  })
+  t.Run("struct from map", func(t *testing.T) {
+    res, err := To[toStruct](map[string]any{"name": "test", "count": "3"})
+    assert.Nil(t, err)
+    assert.Equal(t, toStruct{Name: "test", Count: 3}, res)
+  })
+  t.Run("nil to named type", func(t *testing.T) {
+    res, err := To[toNamedInt](nil)
+    assert.Nil(t, err)
+    assert.Equal(t, toNamedInt(0), res)
+  })
+  t.Run("unsupported", func(t *testing.T) {
+    res, err := To[toNamedInt]([]string{"1"})
+    assert.NotNil(t, err)
+    assert.Equal(t, toNamedInt(0), res)
+  })
+}
+
+func TestUnit_Duration(t *testing.T) {
+  tests := []struct {
+    name      string
+    input     any
+    opts      []ConvertOptions
+    expect    time.Duration
+    errorCode string
+  }{
+    {name: "nil", input: nil, expect: 0},
+    {name: "duration", input: 3 * time.Second, expect: 3 * time.Second},
+    {name: "go format", input: "1h30m", expect: 90 * time.Minute},
+    {name: "go format []byte", input: []byte("250ms"), expect: 250 * time.Millisecond},
+    {name: "go format negative", input: "-1.5s", expect: -1500 * time.Millisecond},
+    {name: "iso time", input: "PT1H30M", expect: 90 * time.Minute},
+    {name: "iso days", input: "P2D", expect: 48 * time.Hour},
+    {name: "iso weeks and time", input: "P1WT1S", expect: 7*24*time.Hour + time.Second},
+    {name: "iso fractional seconds", input: "PT1,5S", expect: 1500 * time.Millisecond},
+    {name: "iso negative", input: "-P1DT12H", expect: -36 * time.Hour},
+    {name: "iso lowercase", input: "pt10m", expect: 10 * time.Minute},
+    {name: "int nanoseconds", input: 1500, expect: 1500 * time.Nanosecond},
+    {name: "int seconds", input: 90, opts: []ConvertOptions{{DurationUnit: time.Second}}, expect: 90 * time.Second},
+    {name: "float seconds", input: 1.5, opts: []ConvertOptions{{DurationUnit: time.Second}}, expect: 1500 * time.Millisecond},
+    {name: "numeric string millis", input: "250", opts: []ConvertOptions{{DurationUnit: time.Millisecond}}, expect: 250 * time.Millisecond},
+    {name: "float string seconds", input: "0.25", opts: []ConvertOptions{{DurationUnit: time.Second}}, expect: 250 * time.Millisecond},
+    {name: "iso months unsupported", input: "P1M", errorCode: ErrorConvertorTypeNotSupported},
+    {name: "iso empty", input: "PT", errorCode: ErrorConvertorTypeNotSupported},
+    {name: "invalid string", input: "soon", errorCode: ErrorConvertorTypeNotSupported},
+    {name: "overflow", input: int64(math.MaxInt64), opts: []ConvertOptions{{DurationUnit: time.Second}}, errorCode: ErrorConvertorNumberOverflow},
+    {name: "uint64 overflow", input: uint64(math.MaxUint64), errorCode: ErrorConvertorNumberOverflow},
+    {name: "float overflow", input: 1e30, errorCode: ErrorConvertorNumberOverflow},
+    {name: "iso overflow", input: "P100000000D", errorCode: ErrorConvertorNumberOverflow},
+    {name: "iso negative overflow", input: "-PT9999999999999999999999S", errorCode: ErrorConvertorNumberOverflow},
+    {name: "strict fraction", input: 1.5, opts: []ConvertOptions{{Strict: true}}, errorCode: ErrorConvertorPrecisionLoss},
+    {name: "unsupported", input: true, errorCode: ErrorConvertorTypeNotSupported},
+  }
+
+  for _, tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      res, err := Duration(tt.input, tt.opts...)
+      if tt.errorCode != "" {
+        if assert.NotNil(t, err) {
+          assert.True(t, err.Has(tt.errorCode), "Expected error code '%s' but got '%s'", tt.errorCode, err.Error())
+        }
+        return
+      }
+      assert.Nil(t, err)
+      assert.Equal(t, tt.expect, res)
+    })
+  }
+
+  t.Run("round trip with String", func(t *testing.T) {
+    str, err := String(90 * time.Minute)
+    assert.Nil(t, err)
+    res, err := Duration(str)
+    assert.Nil(t, err)
+    assert.Equal(t, 90*time.Minute, res)
+  })
+
+  t.Run("ToStruct", func(t *testing.T) {
+    dst := struct {
+      Timeout  time.Duration  `json:"timeout"`
+      Interval *time.Duration `json:"interval"`
+      Retry    time.Duration  `json:"retry"`
+      Count    int64          `json:"count"`
+    }{}
+    config := DefaultParserConfig
+    config.DurationUnit = time.Second
+    err := ToStruct(&dst, config, map[string]any{"timeout": "PT30S", "interval": "1m", "retry": 5, "count": 5})
+    assert.Nil(t, err)
+    assert.Equal(t, 30*time.Second, dst.Timeout)
+    if assert.NotNil(t, dst.Interval) {
+      assert.Equal(t, time.Minute, *dst.Interval)
+    }
+    assert.Equal(t, 5*time.Second, dst.Retry)
+    assert.Equal(t, int64(5), dst.Count)
+  })
+
+  t.Run("generic", func(t *testing.T) {
+    res, err := To[time.Duration]("P1D")
+    assert.Nil(t, err)
+    assert.Equal(t, 24*time.Hour, res)
+  })
+}
+
+func TestUnit_ToByteSize(t *testing.T) {
+  tests := []struct {
+    name      string
+    input     any
+    opts      []ConvertOptions
+    expect    ByteSize
+    errorCode string
+  }{
+    {name: "nil", input: nil, expect: 0},
+    {name: "byte size", input: 5 * KiB, expect: 5 * KiB},
+    {name: "int", input: 1024, expect: KiB},
+    {name: "float", input: 1.9, expect: 1},
+    {name: "plain string", input: "100", expect: 100},
+    {name: "bytes suffix", input: "100B", expect: 100},
+    {name: "si", input: "2GB", expect: 2 * GB},
+    {name: "si lowercase", input: "10mb", expect: 10 * MB},
+    {name: "si kilo", input: "1kB", expect: 1000},
+    {name: "iec", input: "512KiB", expect: 512 * KiB},
+    {name: "iec fraction", input: "1.5GiB", expect: 1536 * MiB},
+    {name: "space before unit", input: []byte(" 1.5 GiB "), expect: 1536 * MiB},
+    {name: "exabytes", input: "15EiB", expect: 15 * EiB},
+    {name: "fractional bytes truncated", input: "1.0005kB", expect: 1000},
+    {name: "fractional bytes rounded", input: "1.0005kB", opts: []ConvertOptions{{Rounding: RoundHalfUp}}, expect: 1001},
+    {name: "number format", input: "1,5 MB", opts: []ConvertOptions{{NumberFormat: &NumberFormatEU}}, expect: 1500 * KB},
+    {name: "saturate", input: "17EiB", opts: []ConvertOptions{{Overflow: OverflowSaturate}}, expect: ByteSize(math.MaxUint64)},
+    {name: "overflow", input: "17EiB", errorCode: ErrorConvertorNumberOverflow},
+    {name: "negative", input: "-1KB", errorCode: ErrorConvertorNumberOverflow},
+    {name: "negative int", input: -1, errorCode: ErrorConvertorNumberOverflow},
+    {name: "strict fraction", input: "1.0005kB", opts: []ConvertOptions{{Strict: true}}, errorCode: ErrorConvertorPrecisionLoss},
+    {name: "unknown unit", input: "10 parsecs", errorCode: ErrorConvertorTypeNotSupported},
+    {name: "missing number", input: "MB", errorCode: ErrorConvertorTypeNotSupported},
+    {name: "unsupported", input: true, errorCode: ErrorConvertorTypeNotSupported},
+  }
+
+  for _, tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      res, err := ToByteSize(tt.input, tt.opts...)
+      if tt.errorCode != "" {
+        if assert.NotNil(t, err) {
+          assert.True(t, err.Has(tt.errorCode), "Expected error code '%s' but got '%s'", tt.errorCode, err.Error())
+        }
+        return
+      }
+      assert.Nil(t, err)
+      assert.Equal(t, tt.expect, res)
+    })
+  }
+
+  t.Run("round trip with String", func(t *testing.T) {
+    res, err := ToByteSize((1536 * MiB).String())
+    assert.Nil(t, err)
+    assert.Equal(t, 1536*MiB, res)
+  })
+
+  t.Run("ToStruct", func(t *testing.T) {
+    dst := struct {
+      MaxUpload ByteSize  `json:"max_upload"`
+      Cache     *ByteSize `json:"cache"`
+      Buffer    ByteSize  `json:"buffer"`
+    }{}
+    err := ToStruct(&dst, map[string]any{"max_upload": "512KiB", "cache": "2GB", "buffer": 4096})
+    assert.Nil(t, err)
+    assert.Equal(t, 512*KiB, dst.MaxUpload)
+    if assert.NotNil(t, dst.Cache) {
+      assert.Equal(t, 2*GB, *dst.Cache)
+    }
+    assert.Equal(t, 4*KiB, dst.Buffer)
+  })
+
+  t.Run("generic", func(t *testing.T) {
+    res, err := To[ByteSize]("1MiB")
+    assert.Nil(t, err)
+    assert.Equal(t, MiB, res)
+  })
+}
+
+func TestUnit_TimeLocation(t *testing.T) {
+  newYork, err := time.LoadLocation("America/New_York")
+  if !assert.NoError(t, err) {
+    return
+  }
+  tokyo, err := time.LoadLocation("Asia/Tokyo")
+  if !assert.NoError(t, err) {
+    return
+  }
+
+  t.Run("zone-less string", func(t *testing.T) {
+    res, err := Time("2023-01-02 15:04:05", ConvertOptions{Location: newYork})
+    assert.Nil(t, err)
+    assert.Equal(t, time.Date(2023, 1, 2, 15, 4, 5, 0, newYork), res)
+
+    res, err = Time("2023-01-02 15:04:05")
+    assert.Nil(t, err)
+    assert.Equal(t, time.UTC, res.Location(), "strings without zone default to UTC")
+  })
+
+  t.Run("zone-less string parsed by dateparse", func(t *testing.T) {
+    res, err := Time("Jan 2, 2023 15:04", ConvertOptions{Location: newYork})
+    assert.Nil(t, err)
+    assert.True(t, time.Date(2023, 1, 2, 15, 4, 0, 0, newYork).Equal(res))
+  })
+
+  t.Run("string with zone keeps its offset", func(t *testing.T) {
+    res, err := Time("2023-01-02T15:04:05Z", ConvertOptions{Location: newYork})
+    assert.Nil(t, err)
+    assert.True(t, time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC).Equal(res))
+  })
+
+  t.Run("unix time", func(t *testing.T) {
+    res, err := Time(1672671845, ConvertOptions{Location: tokyo})
+    assert.Nil(t, err)
+    assert.Equal(t, tokyo, res.Location())
+    assert.Equal(t, int64(1672671845), res.Unix())
+
+    res, err = Time(1672671845.5, ConvertOptions{Location: tokyo})
+    assert.Nil(t, err)
+    assert.Equal(t, tokyo, res.Location())
+  })
+
+  t.Run("date parts", func(t *testing.T) {
+    res, err := Time(2023, 1, 2, 15, 4, 5, 0, ConvertOptions{Location: tokyo})
+    assert.Nil(t, err)
+    assert.Equal(t, time.Date(2023, 1, 2, 15, 4, 5, 0, tokyo), res)
+  })
+
+  t.Run("normalize location", func(t *testing.T) {
+    res, err := Time("2023-01-02 15:04:05", ConvertOptions{Location: newYork, NormalizeLocation: time.UTC})
+    assert.Nil(t, err)
+    assert.Equal(t, time.Date(2023, 1, 2, 20, 4, 5, 0, time.UTC), res)
+
+    res, err = Time(time.Date(2023, 1, 2, 0, 0, 0, 0, tokyo), ConvertOptions{NormalizeLocation: time.UTC})
+    assert.Nil(t, err)
+    assert.Equal(t, time.Date(2023, 1, 1, 15, 0, 0, 0, time.UTC), res)
+
+    res, err = Time(nil, ConvertOptions{NormalizeLocation: tokyo})
+    assert.Nil(t, err)
+    assert.True(t, res.IsZero())
+  })
+
+  t.Run("NullTime uses DefaultConvertOptions", func(t *testing.T) {
+    previous := DefaultConvertOptions
+    DefaultConvertOptions.Location = newYork
+    DefaultConvertOptions.NormalizeLocation = time.UTC
+    t.Cleanup(func() {
+      DefaultConvertOptions = previous
+    })
+
+    nullTime := NullTime{}
+    err := nullTime.Scan("2023-01-02 15:04:05")
+    assert.Nil(t, err)
+    assert.Equal(t, time.Date(2023, 1, 2, 20, 4, 5, 0, time.UTC), nullTime.Time)
+
+    err = nullTime.Scan(time.Date(2023, 1, 2, 0, 0, 0, 0, tokyo))
+    assert.Nil(t, err)
+    assert.Equal(t, time.Date(2023, 1, 1, 15, 0, 0, 0, time.UTC), nullTime.Time)
+  })
+
+  t.Run("ToStruct", func(t *testing.T) {
+    dst := struct {
+      Created time.Time `json:"created"`
+      Updated NullTime  `json:"updated"`
+      Seen    time.Time `json:"seen"`
+    }{}
+    config := DefaultParserConfig
+    config.Location = newYork
+    config.NormalizeLocation = tokyo
+    err := ToStruct(&dst, config, map[string]any{"created": "2023-01-02 15:04:05", "updated": "2023-01-02 15:04:05", "seen": 1672671845})
+    assert.Nil(t, err)
+    assert.Equal(t, time.Date(2023, 1, 3, 5, 4, 5, 0, tokyo), dst.Created)
+    assert.Equal(t, time.Date(2023, 1, 3, 5, 4, 5, 0, tokyo), dst.Updated.Time)
+    assert.Equal(t, tokyo, dst.Seen.Location())
+  })
+}
+
+func TestUnit_TimeEpochUnit(t *testing.T) {
+  expect := time.Date(2023, 11, 14, 22, 13, 20, 0, time.UTC) // 1700000000 seconds
+
+  tests := []struct {
+    name   string
+    input  any
+    unit   EpochUnit
+    expect time.Time
+  }{
+    {name: "default seconds", input: 1700000000, unit: EpochDefault, expect: expect},
+    {name: "seconds", input: int64(1700000000), unit: EpochSeconds, expect: expect},
+    {name: "millis", input: int64(1700000000123), unit: EpochMillis, expect: expect.Add(123 * time.Millisecond)},
+    {name: "micros", input: uint64(1700000000000123), unit: EpochMicros, expect: expect.Add(123 * time.Microsecond)},
+    {name: "nanos", input: int64(1700000000000000123), unit: EpochNanos, expect: expect.Add(123)},
+    {name: "negative millis", input: -1500, unit: EpochMillis, expect: time.Unix(-2, 5e8).UTC()},
+    {name: "float seconds", input: 1700000000.5, unit: EpochSeconds, expect: expect.Add(500 * time.Millisecond)},
+    {name: "float millis", input: 1700000000123.5, unit: EpochMillis, expect: expect.Add(123*time.Millisecond + 500*time.Microsecond)},
+    {name: "auto seconds", input: 1700000000, unit: EpochAuto, expect: expect},
+    {name: "auto millis", input: int64(1700000000123), unit: EpochAuto, expect: expect.Add(123 * time.Millisecond)},
+    {name: "auto micros", input: int64(1700000000000123), unit: EpochAuto, expect: expect.Add(123 * time.Microsecond)},
+    {name: "auto nanos", input: int64(1700000000000000123), unit: EpochAuto, expect: expect.Add(123)},
+    {name: "string millis", input: "1700000000123", unit: EpochMillis, expect: expect.Add(123 * time.Millisecond)},
+    {name: "string float seconds", input: []byte("1700000000.25"), unit: EpochSeconds, expect: expect.Add(250 * time.Millisecond)},
+    {name: "string auto", input: "1700000000000", unit: EpochAuto, expect: expect},
+    {name: "date string with epoch unit", input: "2023-11-14T22:13:20Z", unit: EpochAuto, expect: expect},
+  }
+
+  for _, tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      res, err := Time(tt.input, ConvertOptions{EpochUnit: tt.unit})
+      assert.Nil(t, err)
+      assert.True(t, tt.expect.Equal(res), "expected %s, got %s", tt.expect, res)
+    })
+  }
+
+  t.Run("numeric strings are dates without an epoch unit", func(t *testing.T) {
+    res, err := Time("20231114")
+    assert.Nil(t, err)
+    assert.Equal(t, time.Date(2023, 11, 14, 0, 0, 0, 0, time.UTC), res)
+  })
+
+  t.Run("ToStruct", func(t *testing.T) {
+    dst := struct {
+      CreatedAt time.Time `json:"createdAt"`
+      UpdatedAt time.Time `json:"updatedAt"`
+    }{}
+    config := DefaultParserConfig
+    config.EpochUnit = EpochMillis
+    err := ToStruct(&dst, config, map[string]any{"createdAt": 1700000000123, "updatedAt": "1700000000000"})
+    assert.Nil(t, err)
+    assert.True(t, expect.Add(123*time.Millisecond).Equal(dst.CreatedAt))
+    assert.True(t, expect.Equal(dst.UpdatedAt))
+  })
+}
+
+func TestUnit_TimeSerialDate(t *testing.T) {
+  tests := []struct {
+    name   string
+    input  any
+    unit   EpochUnit
+    expect time.Time
+  }{
+    {name: "excel 1900", input: 44927, unit: EpochExcel1900, expect: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
+    {name: "excel 1900 with time", input: 45123.5, unit: EpochExcel1900, expect: time.Date(2023, 7, 16, 12, 0, 0, 0, time.UTC)},
+    {name: "excel 1900 minutes", input: 45123.75, unit: EpochExcel1900, expect: time.Date(2023, 7, 16, 18, 0, 0, 0, time.UTC)},
+    {name: "excel 1900 first day", input: 1, unit: EpochExcel1900, expect: time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)},
+    {name: "excel 1900 before leap bug", input: 59, unit: EpochExcel1900, expect: time.Date(1900, 2, 28, 0, 0, 0, 0, time.UTC)},
+    {name: "excel 1900 after leap bug", input: 61, unit: EpochExcel1900, expect: time.Date(1900, 3, 1, 0, 0, 0, 0, time.UTC)},
+    {name: "excel 1900 string", input: "45123.5", unit: EpochExcel1900, expect: time.Date(2023, 7, 16, 12, 0, 0, 0, time.UTC)},
+    {name: "excel 1904", input: 43464, unit: EpochExcel1904, expect: time.Date(2022, 12, 31, 0, 0, 0, 0, time.UTC)},
+    {name: "julian day", input: 2460000.5, unit: EpochJulianDay, expect: time.Date(2023, 2, 25, 0, 0, 0, 0, time.UTC)},
+    {name: "julian day noon", input: 2460000, unit: EpochJulianDay, expect: time.Date(2023, 2, 24, 12, 0, 0, 0, time.UTC)},
+    {name: "modified julian day", input: 60000.25, unit: EpochModifiedJulianDay, expect: time.Date(2023, 2, 25, 6, 0, 0, 0, time.UTC)},
+  }
+
+  for _, tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      res, err := Time(tt.input, ConvertOptions{EpochUnit: tt.unit})
+      assert.Nil(t, err)
+      assert.Equal(t, tt.expect, res)
+
+      serial, err := ToSerialDate(res, tt.unit)
+      assert.Nil(t, err)
+      expect, _ := Float64(tt.input)
+      assert.InDelta(t, expect, serial, 1e-9)
+    })
+  }
+
+  t.Run("excel dates are wall clock dates", func(t *testing.T) {
+    tokyo, err := time.LoadLocation("Asia/Tokyo")
+    if !assert.NoError(t, err) {
+      return
+    }
+    res, err := Time(45123.5, ConvertOptions{EpochUnit: EpochExcel1900, Location: tokyo})
+    assert.Nil(t, err)
+    assert.Equal(t, time.Date(2023, 7, 16, 12, 0, 0, 0, tokyo), res)
+
+    serial, err := ToSerialDate(res, EpochExcel1900)
+    assert.Nil(t, err)
+    assert.Equal(t, 45123.5, serial)
+  })
+
+  t.Run("reverse conversion from string", func(t *testing.T) {
+    serial, err := ToSerialDate("2023-07-16 12:00:00", EpochExcel1900)
+    assert.Nil(t, err)
+    assert.Equal(t, 45123.5, serial)
+  })
+
+  t.Run("unsupported unit", func(t *testing.T) {
+    _, err := ToSerialDate(time.Now(), EpochMillis)
+    if assert.NotNil(t, err) {
+      assert.True(t, err.Has(ErrorConvertorTypeNotSupported))
+    }
+  })
+
+  t.Run("ToStruct", func(t *testing.T) {
+    dst := struct {
+      Shipped time.Time `json:"shipped"`
+    }{}
+    config := DefaultParserConfig
+    config.EpochUnit = EpochExcel1900
+    err := ToStruct(&dst, config, map[string]any{"shipped": 45123.5})
+    assert.Nil(t, err)
+    assert.Equal(t, time.Date(2023, 7, 16, 12, 0, 0, 0, time.UTC), dst.Shipped)
+  })
+}
+
+func TestUnit_UUID(t *testing.T) {
+  id := uuid.Must(uuid.FromString("6ba7b810-9dad-11d1-80b4-00c04fd430c8"))
+
+  tests := []struct {
+    name      string
+    input     any
+    expect    uuid.UUID
+    errorCode string
+  }{
+    {name: "nil", input: nil, expect: uuid.Nil},
+    {name: "uuid", input: id, expect: id},
+    {name: "canonical", input: "6ba7b810-9dad-11d1-80b4-00c04fd430c8", expect: id},
+    {name: "upper case", input: "6BA7B810-9DAD-11D1-80B4-00C04FD430C8", expect: id},
+    {name: "braced", input: "{6ba7b810-9dad-11d1-80b4-00c04fd430c8}", expect: id},
+    {name: "urn", input: "urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8", expect: id},
+    {name: "hash like", input: "6ba7b8109dad11d180b400c04fd430c8", expect: id},
+    {name: "array", input: [16]byte(id), expect: id},
+    {name: "raw bytes", input: id.Bytes(), expect: id},
+    {name: "text bytes", input: []byte("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), expect: id},
+    {name: "null uuid", input: uuid.NullUUID{UUID: id, Valid: true}, expect: id},
+    {name: "invalid null uuid", input: uuid.NullUUID{}, expect: uuid.Nil},
+    {name: "zgen null uuid", input: NullUUID{uuid.NullUUID{UUID: id, Valid: true}}, expect: id},
+    {name: "invalid string", input: "6ba7b810-9dad", errorCode: ErrorConvertorTypeNotSupported},
+    {name: "invalid bytes", input: []byte{1, 2, 3}, errorCode: ErrorConvertorTypeNotSupported},
+    {name: "unsupported", input: 42, errorCode: ErrorConvertorTypeNotSupported},
+  }
+
+  for _, tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      res, err := UUID(tt.input)
+      if tt.errorCode != "" {
+        if assert.NotNil(t, err) {
+          assert.True(t, err.Has(tt.errorCode), "Expected error code '%s' but got '%s'", tt.errorCode, err.Error())
+        }
+        return
+      }
+      assert.Nil(t, err)
+      assert.Equal(t, tt.expect, res)
+    })
+  }
+
+  t.Run("String and SliceByte", func(t *testing.T) {
+    str, err := String(id)
+    assert.Nil(t, err)
+    assert.Equal(t, "6ba7b810-9dad-11d1-80b4-00c04fd430c8", str)
+
+    str, err = String(uuid.NullUUID{})
+    assert.Nil(t, err)
+    assert.Equal(t, "", str)
+
+    bytes, err := SliceByte(id)
+    assert.Nil(t, err)
+    assert.Equal(t, id.Bytes(), bytes)
+
+    bytes, err = SliceByte(NullUUID{})
+    assert.Nil(t, err)
+    assert.Equal(t, []byte{}, bytes)
+  })
+
+  t.Run("ToStruct", func(t *testing.T) {
+    dst := struct {
+      ID       uuid.UUID  `json:"id"`
+      ParentID *uuid.UUID `json:"parent_id"`
+      OwnerID  NullUUID   `json:"owner_id"`
+    }{}
+    err := ToStruct(&dst, map[string]any{
+      "id":        "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+      "parent_id": id.Bytes(),
+      "owner_id":  "{6ba7b810-9dad-11d1-80b4-00c04fd430c8}",
+    })
+    assert.Nil(t, err)
+    assert.Equal(t, id, dst.ID)
+    if assert.NotNil(t, dst.ParentID) {
+      assert.Equal(t, id, *dst.ParentID)
+    }
+    assert.True(t, dst.OwnerID.Valid)
+    assert.Equal(t, id, dst.OwnerID.UUID)
+
+    err = ToStruct(&dst, map[string]any{"id": "not a uuid"})
+    if assert.NotNil(t, err) {
+      assert.True(t, err.Has(ErrorConvertorTypeNotSupported))
+    }
+  })
+
+  t.Run("generic", func(t *testing.T) {
+    res, err := To[uuid.UUID]("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+    assert.Nil(t, err)
+    assert.Equal(t, id, res)
+
+    nullRes, err := To[NullUUID](uuid.NullUUID{UUID: id})
+    assert.Nil(t, err)
+    assert.False(t, nullRes.Valid)
+  })
+}
+
+func TestUnit_SliceGeneric(t *testing.T) {
+  t.Run("float64", func(t *testing.T) {
+    res, err := Slice[float64]([]any{1, "2.5", float32(0.5), int64(-3)})
+    assert.Nil(t, err)
+    assert.Equal(t, []float64{1, 2.5, 0.5, -3}, res)
+  })
+
+  t.Run("bool from array", func(t *testing.T) {
+    res, err := Slice[bool]([3]string{"true", "false", "1"})
+    assert.Nil(t, err)
+    assert.Equal(t, []bool{true, false, true}, res)
+  })
+
+  t.Run("uint64 from typed slice", func(t *testing.T) {
+    res, err := Slice[uint64]([]string{"18446744073709551615", "0x10"})
+    assert.Nil(t, err)
+    assert.Equal(t, []uint64{math.MaxUint64, 16}, res)
+  })
+
+  t.Run("time", func(t *testing.T) {
+    res, err := Slice[time.Time]([]any{"2023-01-02T15:04:05Z", int64(0)})
+    assert.Nil(t, err)
+    if assert.Len(t, res, 2) {
+      assert.True(t, res[0].Equal(time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC)))
+      assert.Equal(t, int64(0), res[1].Unix())
+    }
+  })
+
+  t.Run("decimal", func(t *testing.T) {
+    res, err := Slice[decimal.Decimal]([]any{"1.10", 2})
+    assert.Nil(t, err)
+    if assert.Len(t, res, 2) {
+      assert.True(t, decimal.RequireFromString("1.1").Equal(res[0]))
+      assert.True(t, decimal.NewFromInt(2).Equal(res[1]))
+    }
+  })
+
+  t.Run("single scalar", func(t *testing.T) {
+    res, err := Slice[int]("42")
+    assert.Nil(t, err)
+    assert.Equal(t, []int{42}, res)
+
+    times, err := Slice[time.Time](time.Unix(10, 0))
+    assert.Nil(t, err)
+    assert.Len(t, times, 1)
+  })
+
+  t.Run("same type", func(t *testing.T) {
+    src := []string{"a", "b"}
+    res, err := Slice[string](src)
+    assert.Nil(t, err)
+    assert.Equal(t, src, res)
+  })
+
+  t.Run("nil and empty", func(t *testing.T) {
+    res, err := Slice[int](nil)
+    assert.Nil(t, err)
+    assert.Equal(t, []int{}, res)
+
+    res, err = Slice[int]("", ConvertOptions{Separator: ","})
+    assert.Nil(t, err)
+    assert.Equal(t, []int{}, res)
+  })
+
+  t.Run("delimited string", func(t *testing.T) {
+    res, err := Slice[int]("1, 2 ,3", ConvertOptions{Separator: ","})
+    assert.Nil(t, err)
+    assert.Equal(t, []int{1, 2, 3}, res)
+
+    words, err := Slice[string]("a|b|c", ConvertOptions{Separator: "|"})
+    assert.Nil(t, err)
+    assert.Equal(t, []string{"a", "b", "c"}, words)
+
+    words, err = Slice[string]("a|b|c")
+    assert.Nil(t, err)
+    assert.Equal(t, []string{"a|b|c"}, words)
+  })
+
+  t.Run("options are applied to the elements", func(t *testing.T) {
+    _, err := Slice[int]([]any{1, 2.5}, ConvertOptions{Strict: true})
+    if assert.NotNil(t, err) {
+      assert.True(t, err.Has(ErrorConvertorPrecisionLoss))
+    }
+  })
+
+  t.Run("error names the failing index", func(t *testing.T) {
+    res, err := Slice[int]([]any{1, 2, "three"})
+    assert.Equal(t, []int{}, res)
+    if assert.NotNil(t, err) {
+      assert.True(t, err.Has(ErrorConvertorElementFailed))
+      assert.True(t, err.Has(ErrorConvertorTypeNotSupported))
+      assert.Equal(t, 2, err.Get().Args()["index"])
+    }
+  })
+
+  t.Run("unsupported source", func(t *testing.T) {
+    _, err := Slice[int](map[string]any{"a": 1})
+    if assert.NotNil(t, err) {
+      assert.True(t, err.Has(ErrorConvertorTypeNotSupported))
+    }
+  })
+}
+
+func TestUnit_Map(t *testing.T) {
+  t.Run("key and value coercion", func(t *testing.T) {
+    res, err := Map[int, string](map[string]any{"1": "one", "2": 2})
+    assert.Nil(t, err)
+    assert.Equal(t, map[int]string{1: "one", 2: "2"}, res)
+  })
+
+  t.Run("float values", func(t *testing.T) {
+    res, err := Map[string, float64](map[string]any{"a": "1.5", "b": 2})
+    assert.Nil(t, err)
+    assert.Equal(t, map[string]float64{"a": 1.5, "b": 2}, res)
+  })
+
+  t.Run("struct", func(t *testing.T) {
+    src := struct {
+      Width  int    `json:"width"`
+      Height string `json:"height"`
+    }{Width: 3, Height: "4"}
+    res, err := Map[string, int](src)
+    assert.Nil(t, err)
+    assert.Equal(t, map[string]int{"width": 3, "height": 4}, res)
+
+    res, err = Map[string, int](&src)
+    assert.Nil(t, err)
+    assert.Equal(t, map[string]int{"width": 3, "height": 4}, res)
+  })
+
+  t.Run("key value pairs", func(t *testing.T) {
+    res, err := Map[string, int]([][2]any{{"a", "1"}, {"b", 2}})
+    assert.Nil(t, err)
+    assert.Equal(t, map[string]int{"a": 1, "b": 2}, res)
+
+    res, err = Map[string, int]([]any{[]any{"a", 1}, map[string]any{"key": "b", "value": "2"}})
+    assert.Nil(t, err)
+    assert.Equal(t, map[string]int{"a": 1, "b": 2}, res)
+  })
+
+  t.Run("same type", func(t *testing.T) {
+    src := map[string]bool{"a": true}
+    res, err := Map[string, bool](src)
+    assert.Nil(t, err)
+    assert.Equal(t, src, res)
+  })
+
+  t.Run("nil", func(t *testing.T) {
+    res, err := Map[string, int](nil)
+    assert.Nil(t, err)
+    assert.Equal(t, map[string]int{}, res)
+  })
+
+  t.Run("error names the failing key", func(t *testing.T) {
+    _, err := Map[string, int](map[string]any{"a": 1, "b": "two"})
+    if assert.NotNil(t, err) {
+      assert.True(t, err.Has(ErrorConvertorElementFailed))
+      assert.True(t, err.Has(ErrorConvertorTypeNotSupported))
+      assert.Equal(t, "b", err.Get().Args()["key"])
+    }
+
+    _, err = Map[int, string](map[string]any{"x": "a"})
+    if assert.NotNil(t, err) {
+      assert.True(t, err.Has(ErrorConvertorElementFailed))
+      assert.Equal(t, "x", err.Get().Args()["key"])
+    }
+  })
+
+  t.Run("duplicate converted keys", func(t *testing.T) {
+    _, err := Map[int, string](map[string]any{"1": "a", "01": "b"})
+    if assert.NotNil(t, err) {
+      assert.True(t, err.Has(ErrorConvertorElementFailed))
+    }
+
+    _, err = Map[string, int]([][2]any{{"a", 1}, {"a", 2}})
+    if assert.NotNil(t, err) {
+      assert.True(t, err.Has(ErrorConvertorElementFailed))
+      assert.Equal(t, "a", err.Get().Args()["key"])
+    }
+  })
+
+  t.Run("invalid pairs", func(t *testing.T) {
+    _, err := Map[string, int]([]any{[]any{"a", 1, 2}})
+    if assert.NotNil(t, err) {
+      assert.True(t, err.Has(ErrorConvertorTypeNotSupported))
+    }
+
+    _, err = Map[string, int]("a=1")
+    if assert.NotNil(t, err) {
+      assert.True(t, err.Has(ErrorConvertorTypeNotSupported))
+    }
+  })
+
+  t.Run("options are applied to the values", func(t *testing.T) {
+    _, err := Map[string, int](map[string]any{"a": 1.5}, ConvertOptions{Strict: true})
+    if assert.NotNil(t, err) {
+      assert.True(t, err.Has(ErrorConvertorPrecisionLoss))
+    }
+  })
+
+  t.Run("ToStruct", func(t *testing.T) {
+    dst := struct {
+      Names   map[int]string     `json:"names"`
+      Weights map[string]float64 `json:"weights"`
+      Limits  map[string]uint16  `json:"limits"`
+    }{}
+    err := ToStruct(&dst, map[string]any{
+      "names":   map[string]any{"1": "one", "2": "two"},
+      "weights": map[string]any{"a": "0.5"},
+      "limits":  []any{[]any{"cpu", "2"}, []any{"memory", 512}},
+    })
+    assert.Nil(t, err)
+    assert.Equal(t, map[int]string{1: "one", 2: "two"}, dst.Names)
+    assert.Equal(t, map[string]float64{"a": 0.5}, dst.Weights)
+    assert.Equal(t, map[string]uint16{"cpu": 2, "memory": 512}, dst.Limits)
+
+    err = ToStruct(&dst, map[string]any{"weights": map[string]any{"b": "heavy"}})
+    if assert.NotNil(t, err) {
+      assert.True(t, err.Has(ErrorConvertorElementFailed))
+      assert.Equal(t, "b", err.Get().Args()["key"])
+    }
+
+    err = ToStruct(&dst, map[string]any{"names": map[string]any{"7": "seven", "007": "bond"}})
+    if assert.NotNil(t, err) {
+      assert.True(t, err.Has(ErrorConvertorElementFailed))
+    }
+  })
+}
@@ -0,0 +1,100 @@
+package zgen
+
+import (
+  "encoding/json"
+  "errors"
+  "github.com/znxlc/zerror"
+  "io"
+  "strings"
+)
+
+// decodeString - opt-in decoding of the string sources of the slice and map converters
+// with ConvertOptions.JSONStrings, strings starting with "[" or "{" are decoded as JSON ([]any or map[string]any, numbers as json.Number)
+// with ConvertOptions.Separator, the other strings are split into a []any of strings (see splitDelimited)
+// other sources, or strings when the options are not set, are returned unchanged
+func decodeString(src any, dstType string, opt ConvertOptions) (dst any, err zerror.Error) {
+  str, ok := src.(string)
+  if !ok {
+    return src, nil
+  }
+  trimmed := strings.TrimSpace(str)
+  if opt.JSONStrings && (strings.HasPrefix(trimmed, "[") || strings.HasPrefix(trimmed, "{")) {
+    var decoded any
+    decoder := json.NewDecoder(strings.NewReader(trimmed))
+    decoder.UseNumber() // json.Number keeps the integers above 2^53 exact
+    if er := decoder.Decode(&decoded); er != nil {
+      return src, strictInvalidSyntaxError(src, dstType, er)
+    }
+    if _, er := decoder.Token(); er != io.EOF {
+      return src, strictInvalidSyntaxError(src, dstType, errors.New("invalid data after the JSON value"))
+    }
+    return decoded, nil
+  }
+  if opt.Separator == "" {
+    return src, nil
+  }
+  parts, er := splitDelimited(str, opt.Separator)
+  if er != nil {
+    return src, strictInvalidSyntaxError(src, dstType, er)
+  }
+  result := make([]any, 0, len(parts))
+  for _, part := range parts {
+    result = append(result, part)
+  }
+  return result, nil
+}
+
+// splitDelimited - splits str on the separator with CSV style quoting, the parts are trimmed and an empty string has no parts
+// parts enclosed in double quotes can contain the separator, a doubled quote inside them is a literal quote (`a, "b, ""c"""` is [a b, "c"])
+func splitDelimited(str string, separator string) (parts []string, err error) {
+  parts = []string{}
+  if strings.TrimSpace(str) == "" {
+    return parts, nil
+  }
+  for {
+    rest := strings.TrimLeft(str, " \t")
+    if !strings.HasPrefix(rest, `"`) { // unquoted part, up to the next separator
+      idx := strings.Index(str, separator)
+      if idx < 0 {
+        return append(parts, strings.TrimSpace(str)), nil
+      }
+      parts = append(parts, strings.TrimSpace(str[:idx]))
+      str = str[idx+len(separator):]
+      continue
+    }
+
+    var part strings.Builder
+    rest = rest[1:]
+    for {
+      idx := strings.Index(rest, `"`)
+      if idx < 0 {
+        return nil, errors.New("missing closing quote")
+      }
+      part.WriteString(rest[:idx])
+      rest = rest[idx+1:]
+      if !strings.HasPrefix(rest, `"`) { // closing quote
+        break
+      }
+      part.WriteString(`"`) // doubled quote
+      rest = rest[1:]
+    }
+    parts = append(parts, part.String())
+
+    rest = strings.TrimLeft(rest, " \t")
+    if rest == "" {
+      return parts, nil
+    }
+    if !strings.HasPrefix(rest, separator) {
+      return nil, errors.New("unexpected characters after closing quote")
+    }
+    str = rest[len(separator):]
+  }
+}
+
+// jsonNumberValue - returns json.Number sources as strings for the numeric converters
+func jsonNumberValue(src any) any {
+  if val, ok := src.(json.Number); ok {
+    return string(val)
+  }
+  return src
+}
@@ -0,0 +1,114 @@
+package zgen
+
+import (
+  "encoding/json"
+  "testing"
+
+  "github.com/stretchr/testify/assert"
+)
+
+func TestUnit_SplitDelimited(t *testing.T) {
+  tests := []struct {
+    name      string
+    input     string
+    separator string
+    expect    []string
+    hasError  bool
+  }{
+    {name: "simple", input: "a,b,c", separator: ",", expect: []string{"a", "b", "c"}},
+    {name: "trimmed", input: " a , b ,c ", separator: ",", expect: []string{"a", "b", "c"}},
+    {name: "empty", input: "  ", separator: ",", expect: []string{}},
+    {name: "empty parts", input: "a,,b,", separator: ",", expect: []string{"a", "", "b", ""}},
+    {name: "multi character separator", input: "a::b::c", separator: "::", expect: []string{"a", "b", "c"}},
+    {name: "quoted separator", input: `a,"b, c",d`, separator: ",", expect: []string{"a", "b, c", "d"}},
+    {name: "quoted spaces kept", input: `" a ", b`, separator: ",", expect: []string{" a ", "b"}},
+    {name: "doubled quote", input: `"say ""hi""",x`, separator: ",", expect: []string{`say "hi"`, "x"}},
+    {name: "quote inside unquoted part", input: `5" disk,x`, separator: ",", expect: []string{`5" disk`, "x"}},
+    {name: "quoted last part", input: `a;"b;c"`, separator: ";", expect: []string{"a", "b;c"}},
+    {name: "missing closing quote", input: `a,"b`, separator: ",", hasError: true},
+    {name: "characters after quote", input: `"a"b,c`, separator: ",", hasError: true},
+  }
+
+  for _, tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      res, err := splitDelimited(tt.input, tt.separator)
+      if tt.hasError {
+        assert.NotNil(t, err)
+        return
+      }
+      assert.Nil(t, err)
+      assert.Equal(t, tt.expect, res)
+    })
+  }
+}
+
+func TestUnit_StringDecoding(t *testing.T) {
+  csv := ConvertOptions{Separator: ","}
+  jsonOpt := ConvertOptions{JSONStrings: true}
+  both := ConvertOptions{Separator: ",", JSONStrings: true}
+
+  tests := []struct {
+    name      string
+    convert   func() (any, error)
+    expect    any
+    errorCode string
+  }{
+    {name: "slice any delimited", convert: func() (any, error) { return SliceAny("a, b", csv) }, expect: []any{"a", "b"}},
+    {name: "slice any json", convert: func() (any, error) { return SliceAny(`[1, "x", true]`, jsonOpt) }, expect: []any{json.Number("1"), "x", true}},
+    {name: "slice string delimited", convert: func() (any, error) { return SliceString(`a,"b,c"`, csv) }, expect: []string{"a", "b,c"}},
+    {name: "slice string json", convert: func() (any, error) { return SliceString(`["a","b"]`, both) }, expect: []string{"a", "b"}},
+    {name: "slice string without options", convert: func() (any, error) { return SliceString("a,b,c") }, expect: []string{"a,b,c"}},
+    {name: "slice int delimited", convert: func() (any, error) { return SliceInt("1, 2, 3", csv) }, expect: []int{1, 2, 3}},
+    {name: "slice int json", convert: func() (any, error) { return SliceInt("[1,2,3]", jsonOpt) }, expect: []int{1, 2, 3}},
+    {name: "map json", convert: func() (any, error) { return MapStringAny(`{"a": 1}`, jsonOpt) }, expect: map[string]any{"a": json.Number("1")}},
+    {name: "slice map json", convert: func() (any, error) { return SliceMapStringAny(`[{"a": 1}, {"b": "c"}]`, jsonOpt) }, expect: []map[string]any{{"a": json.Number("1")}, {"b": "c"}}},
+    {name: "slice map single json object", convert: func() (any, error) { return SliceMapStringAny(`{"a": 1}`, jsonOpt) }, expect: []map[string]any{{"a": json.Number("1")}}},
+    {name: "generic slice", convert: func() (any, error) { return Slice[float64](`1.5;"2"`, ConvertOptions{Separator: ";"}) }, expect: []float64{1.5, 2}},
+    {name: "generic map", convert: func() (any, error) { return Map[string, int](`{"a": "1"}`, jsonOpt) }, expect: map[string]int{"a": 1}},
+    {name: "slice int json large", convert: func() (any, error) { return SliceInt("[9007199254740993]", jsonOpt) }, expect: []int{9007199254740993}},
+    {name: "slice int json large strict", convert: func() (any, error) { return SliceInt("[9007199254740993]", ConvertOptions{JSONStrings: true, Strict: true}) }, expect: []int{9007199254740993}},
+    {name: "slice uint64 json max", convert: func() (any, error) { return Slice[uint64]("[18446744073709551615]", jsonOpt) }, expect: []uint64{18446744073709551615}},
+    {name: "slice float json", convert: func() (any, error) { return Slice[float64]("[1.5, 2e3]", jsonOpt) }, expect: []float64{1.5, 2000}},
+    {name: "json data after value", convert: func() (any, error) { return SliceInt("[1] [2]", jsonOpt) }, errorCode: ErrorConvertorInvalidSyntax},
+    {name: "invalid json", convert: func() (any, error) { return SliceInt("[1,2", jsonOpt) }, errorCode: ErrorConvertorInvalidSyntax},
+    {name: "invalid quoting", convert: func() (any, error) { return SliceString(`"a`, csv) }, errorCode: ErrorConvertorInvalidSyntax},
+    {name: "json disabled", convert: func() (any, error) { return MapStringAny(`{"a": 1}`) }, errorCode: ErrorConvertorTypeNotSupported},
+  }
+
+  for _, tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      res, err := tt.convert()
+      if tt.errorCode != "" {
+        if assert.NotNil(t, err) {
+          assert.True(t, err.(interface{ Has(string) bool }).Has(tt.errorCode), "Expected error code '%s' but got '%s'", tt.errorCode, err.Error())
+        }
+        return
+      }
+      assert.Nil(t, err)
+      assert.Equal(t, tt.expect, res)
+    })
+  }
+
+  t.Run("ToStruct", func(t *testing.T) {
+    dst := struct {
+      Hosts  []string          `json:"hosts"`
+      Ports  []int             `json:"ports"`
+      Labels map[string]string `json:"labels"`
+    }{}
+    config := DefaultParserConfig
+    config.Separator = ","
+    config.JSONStrings = true
+    err := ToStruct(&dst, config, map[string]any{
+      "hosts":  "a.example.com, b.example.com",
+      "ports":  "[80, 443]",
+      "labels": `{"env": "prod"}`,
+    })
+    assert.Nil(t, err)
+    assert.Equal(t, []string{"a.example.com", "b.example.com"}, dst.Hosts)
+    assert.Equal(t, []int{80, 443}, dst.Ports)
+    assert.Equal(t, map[string]string{"env": "prod"}, dst.Labels)
+
+    err = ToStruct(&dst, map[string]any{"ports": "80,443"})
+    assert.NotNil(t, err)
+  })
+}
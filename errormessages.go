@@ -8,6 +8,14 @@ import (
 const (
   ErrorConvertorTypeNotSupported = "ERROR_ZGEN_CONVERTOR_TYPE_NOT_SUPPORTED"
   ErrorConvertorNumberOverflow   = "ERROR_ZGEN_CONVERTOR_NUMBER_OVERFLOW"
+  ErrorConvertorCustomFailed     = "ERROR_ZGEN_CONVERTOR_CUSTOM_FAILED"
+  ErrorConvertorPrecisionLoss    = "ERROR_ZGEN_CONVERTOR_PRECISION_LOSS"
+  ErrorConvertorInvalidSyntax    = "ERROR_ZGEN_CONVERTOR_INVALID_SYNTAX"
+  ErrorConvertorInvalidLayout    = "ERROR_ZGEN_CONVERTOR_INVALID_LAYOUT"
+  ErrorConvertorElementFailed    = "ERROR_ZGEN_CONVERTOR_ELEMENT_FAILED"
+  ErrorConvertorNonFinite        = "ERROR_ZGEN_CONVERTOR_NON_FINITE"
+
+  ErrorConvertorInvalidNumberFormat = "ERROR_ZGEN_CONVERTOR_INVALID_NUMBER_FORMAT"
 
   // Scanner Errors
   ErrorZGENScannerEvaluate            = "ERROR_ZGEN_SCANNER_EVALUATE"
@@ -29,6 +37,34 @@ var ErrorMap = map[string]errormessage.Message{
     Code: ErrorConvertorNumberOverflow,
     Msg:  "ZGEN Conversion Error, number overflow",
   },
+  ErrorConvertorCustomFailed: {
+    Code: ErrorConvertorCustomFailed,
+    Msg:  "ZGEN Conversion Error, registered converter failed",
+  },
+  ErrorConvertorPrecisionLoss: {
+    Code: ErrorConvertorPrecisionLoss,
+    Msg:  "ZGEN Conversion Error, precision loss",
+  },
+  ErrorConvertorInvalidSyntax: {
+    Code: ErrorConvertorInvalidSyntax,
+    Msg:  "ZGEN Conversion Error, invalid syntax",
+  },
+  ErrorConvertorInvalidLayout: {
+    Code: ErrorConvertorInvalidLayout,
+    Msg:  "ZGEN Conversion Error, time layout can not be translated",
+  },
+  ErrorConvertorElementFailed: {
+    Code: ErrorConvertorElementFailed,
+    Msg:  "ZGEN Conversion Error, element conversion failed",
+  },
+  ErrorConvertorNonFinite: {
+    Code: ErrorConvertorNonFinite,
+    Msg:  "ZGEN Conversion Error, NaN or infinite value",
+  },
+  ErrorConvertorInvalidNumberFormat: {
+    Code: ErrorConvertorInvalidNumberFormat,
+    Msg:  "ZGEN Conversion Error, ambiguous number format",
+  },
 
   // Scanner errors
   ErrorZGENScannerEvaluate: {
@@ -0,0 +1,248 @@
+package zgen
+
+import (
+  "github.com/znxlc/zerror"
+  "strings"
+)
+
+// LayoutStyle - syntax of a time layout
+type LayoutStyle int
+
+const (
+  LayoutGo       LayoutStyle = iota // Go reference layout ("2006-01-02 15:04")
+  LayoutStrftime                    // C strftime directives ("%Y-%m-%d %H:%M")
+  LayoutJava                        // Java DateTimeFormatter / SimpleDateFormat patterns ("yyyy-MM-dd HH:mm", literals in single quotes)
+  LayoutMoment                      // moment.js / day.js tokens ("YYYY-MM-DD HH:mm", literals in square brackets)
+)
+
+// strftimeDirectives - strftime directives and their Go layout
+var strftimeDirectives = map[string]string{
+  "Y": "2006", "y": "06", "m": "01", "-m": "1", "b": "Jan", "h": "Jan", "B": "January",
+  "d": "02", "-d": "2", "e": "_2", "j": "002", "a": "Mon", "A": "Monday",
+  "H": "15", "-H": "15", "I": "03", "-I": "3", "M": "04", "-M": "4", "S": "05", "-S": "5",
+  "L": "000", "f": "000000", "N": "000000000", "p": "PM", "P": "pm",
+  "z": "-0700", ":z": "-07:00", "Z": "MST",
+  "F": "2006-01-02", "T": "15:04:05", "D": "01/02/06", "R": "15:04", "r": "03:04:05 PM",
+  "c": "Mon Jan _2 15:04:05 2006", "x": "01/02/06", "X": "15:04:05",
+  "%": "%", "n": "\n", "t": "\t",
+}
+
+// javaTokens - Java pattern letters, the layout depends on the number of repeated letters (the last entry is used for longer runs)
+var javaTokens = map[byte][]string{
+  'y': {"2006", "06", "2006", "2006"},
+  'u': {"2006", "06", "2006", "2006"},
+  'M': {"1", "01", "Jan", "January"},
+  'L': {"1", "01", "Jan", "January"},
+  'd': {"2", "02"},
+  'D': {"", "", "002"},
+  'E': {"Mon", "Mon", "Mon", "Monday"},
+  'a': {"PM"},
+  'H': {"15", "15"},
+  'h': {"3", "03"},
+  'm': {"4", "04"},
+  's': {"5", "05"},
+  'z': {"MST", "MST", "MST", ""},
+  'Z': {"-0700", "-0700", "-0700", "", "-07:00"},
+  'X': {"Z07", "Z0700", "Z07:00"},
+  'x': {"-07", "-0700", "-07:00"},
+}
+
+// momentTokens - moment.js tokens, the layout depends on the number of repeated letters (empty entries are not supported)
+var momentTokens = map[byte][]string{
+  'Y': {"", "06", "", "2006"},
+  'M': {"1", "01", "Jan", "January"},
+  'D': {"2", "02", "", "002"},
+  'd': {"", "", "Mon", "Monday"},
+  'H': {"15", "15"},
+  'h': {"3", "03"},
+  'A': {"PM"},
+  'a': {"pm"},
+  'm': {"4", "04"},
+  's': {"5", "05"},
+  'Z': {"-07:00", "-0700"},
+  'z': {"MST", "MST"},
+  'X': {""}, 'x': {""}, 'Q': {""}, 'W': {""}, 'w': {""}, 'E': {""}, 'e': {""}, 'k': {""}, 'G': {""}, 'g': {""}, 'o': {""}, // moment tokens without a Go equivalent
+}
+
+// TranslateLayout - translates a strftime, Java or moment layout to a Go reference layout
+// returns ErrorConvertorInvalidLayout for directives without a Go equivalent and for literal text that Go would read as a layout element
+// example:
+//
+//	layout, err := TranslateLayout("%Y-%m-%d %H:%M", LayoutStrftime) // "2006-01-02 15:04"
+//	layout, err = TranslateLayout("yyyy-MM-dd'T'HH:mm:ss.SSS", LayoutJava) // "2006-01-02T15:04:05.000"
+func TranslateLayout(layout string, style LayoutStyle) (dst string, err zerror.Error) {
+  switch style {
+  case LayoutGo:
+    return layout, nil
+  case LayoutStrftime:
+    return translateStrftime(layout)
+  case LayoutJava:
+    return translatePattern(layout, javaTokens, '\'', '\'', true)
+  case LayoutMoment:
+    return translatePattern(layout, momentTokens, '[', ']', false)
+  }
+  return "", layoutError(layout, "unknown layout style")
+}
+
+// layoutError - returns the invalid layout error
+func layoutError(layout string, reason string) zerror.Error {
+  return zerror.New(ErrorConvertorInvalidLayout, map[string]any{
+    "layout": layout,
+    "error":  reason,
+  })
+}
+
+// goLayoutLiteral - checks that literal text is not read as a layout element by Go (Go layouts have no escape sequence)
+func goLayoutLiteral(literal string) bool {
+  if strings.ContainsAny(literal, "0123456789") {
+    return false
+  }
+  for _, element := range []string{"Jan", "Mon", "MST", "PM", "pm"} {
+    if strings.Contains(literal, element) {
+      return false
+    }
+  }
+  return true
+}
+
+// translateStrftime - translates strftime directives, the other characters are literals
+func translateStrftime(layout string) (dst string, err zerror.Error) {
+  var result, literal strings.Builder
+  flushLiteral := func() bool {
+    ok := goLayoutLiteral(literal.String())
+    result.WriteString(literal.String())
+    literal.Reset()
+    return ok
+  }
+  for idx := 0; idx < len(layout); idx++ {
+    if layout[idx] != '%' {
+      literal.WriteByte(layout[idx])
+      continue
+    }
+    if !flushLiteral() {
+      return "", layoutError(layout, "literal text conflicts with the Go layout elements")
+    }
+    directive := ""
+    if idx+1 < len(layout) {
+      directive = layout[idx+1 : idx+2]
+      if (directive == "-" || directive == ":") && idx+2 < len(layout) { // flags: %-d (no padding), %:z (colon offset)
+        directive = layout[idx+1 : idx+3]
+      }
+    }
+    goLayout, ok := strftimeDirectives[directive]
+    if !ok {
+      return "", layoutError(layout, "unsupported directive %"+directive)
+    }
+    if strings.Trim(goLayout, "0") == "" && !endsWithFractionSeparator(result.String()) { // Go fractional seconds must follow a separator
+      return "", layoutError(layout, "fractional seconds must follow '.' or ','")
+    }
+    result.WriteString(goLayout)
+    idx += len(directive)
+  }
+  if !flushLiteral() {
+    return "", layoutError(layout, "literal text conflicts with the Go layout elements")
+  }
+  return result.String(), nil
+}
+
+// translatePattern - translates letter patterns (Java, moment), letters are grouped in runs of the same letter,
+// text between quoteStart and quoteEnd is literal and reservedLetters rejects the unknown letters (Java) instead of keeping them as literals (moment)
+func translatePattern(layout string, tokens map[byte][]string, quoteStart byte, quoteEnd byte, reservedLetters bool) (dst string, err zerror.Error) {
+  var result strings.Builder
+  writeLiteral := func(literal string) zerror.Error {
+    if !goLayoutLiteral(literal) {
+      return layoutError(layout, "literal text conflicts with the Go layout elements: "+literal)
+    }
+    result.WriteString(literal)
+    return nil
+  }
+  for idx := 0; idx < len(layout); {
+    char := layout[idx]
+    if char == quoteStart { // quoted literal, '' is a single quote in Java
+      end := strings.IndexByte(layout[idx+1:], quoteEnd)
+      if end < 0 {
+        return "", layoutError(layout, "unterminated literal")
+      }
+      literal := layout[idx+1 : idx+1+end]
+      if end == 0 && quoteStart == quoteEnd {
+        literal = string(quoteEnd)
+      }
+      if err = writeLiteral(literal); err != nil {
+        return "", err
+      }
+      idx += end + 2
+      continue
+    }
+    isLetter := (char >= 'a' && char <= 'z') || (char >= 'A' && char <= 'Z')
+    if !isLetter {
+      if err = writeLiteral(string(char)); err != nil {
+        return "", err
+      }
+      idx++
+      continue
+    }
+
+    count := 1
+    for idx+count < len(layout) && layout[idx+count] == char {
+      count++
+    }
+    if char == 'S' { // fractional seconds, one digit per letter
+      if !endsWithFractionSeparator(result.String()) {
+        return "", layoutError(layout, "fractional seconds must follow '.' or ','")
+      }
+      result.WriteString(strings.Repeat("0", count))
+      idx += count
+      continue
+    }
+    variants, ok := tokens[char]
+    if !ok {
+      if reservedLetters {
+        return "", layoutError(layout, "unsupported pattern letter "+string(char))
+      }
+      if err = writeLiteral(layout[idx : idx+count]); err != nil {
+        return "", err
+      }
+      idx += count
+      continue
+    }
+    goLayout := variants[len(variants)-1]
+    if count <= len(variants) {
+      goLayout = variants[count-1]
+    }
+    if goLayout == "" {
+      return "", layoutError(layout, "unsupported pattern "+layout[idx:idx+count])
+    }
+    result.WriteString(goLayout)
+    idx += count
+  }
+  return result.String(), nil
+}
+
+// endsWithFractionSeparator - checks if the translated layout ends with a seconds element followed by '.' or ','
+func endsWithFractionSeparator(layout string) bool {
+  return strings.HasSuffix(layout, "5.") || strings.HasSuffix(layout, "5,")
+}
+
+// FormatTime - converts any value accepted by Time and formats it with the layout, the time formatting variant of String
+// the layout uses ConvertOptions.TimeLayoutStyle, an empty layout uses ConvertOptions.TimeLayout and TimeFormatISOSTZ (the String format) if both are empty
+// example:
+//
+//	str, err := FormatTime(1700000000, "%d/%m/%Y %H:%M", ConvertOptions{TimeLayoutStyle: LayoutStrftime, Location: time.UTC}) // "14/11/2023 22:13"
+func FormatTime(src any, layout string, opts ...ConvertOptions) (dst string, err zerror.Error) {
+  opt := getConvertOptions(opts)
+  if layout == "" {
+    layout = opt.TimeLayout
+  }
+  if layout == "" {
+    layout, opt.TimeLayoutStyle = TimeFormatISOSTZ, LayoutGo
+  }
+  goLayout, err := TranslateLayout(layout, opt.TimeLayoutStyle)
+  if err != nil {
+    return "", err
+  }
+  timeVal, err := Time(src, opt)
+  if err != nil {
+    return "", err
+  }
+  return timeVal.Format(goLayout), nil
+}
@@ -0,0 +1,126 @@
+package zgen
+
+import (
+  "testing"
+  "time"
+
+  "github.com/stretchr/testify/assert"
+)
+
+func TestUnit_TranslateLayout(t *testing.T) {
+  tests := []struct {
+    name   string
+    layout string
+    style  LayoutStyle
+    expect string
+  }{
+    {name: "go", layout: "2006-01-02", style: LayoutGo, expect: "2006-01-02"},
+    {name: "strftime datetime", layout: "%Y-%m-%d %H:%M", style: LayoutStrftime, expect: "2006-01-02 15:04"},
+    {name: "strftime names", layout: "%a, %d %b %Y %I:%M:%S %p %Z", style: LayoutStrftime, expect: "Mon, 02 Jan 2006 03:04:05 PM MST"},
+    {name: "strftime no padding", layout: "%-d/%-m/%y", style: LayoutStrftime, expect: "2/1/06"},
+    {name: "strftime fraction", layout: "%H:%M:%S.%f%:z", style: LayoutStrftime, expect: "15:04:05.000000-07:00"},
+    {name: "strftime shortcuts", layout: "%FT%T%z", style: LayoutStrftime, expect: "2006-01-02T15:04:05-0700"},
+    {name: "strftime percent", layout: "%d%%", style: LayoutStrftime, expect: "02%"},
+    {name: "java datetime", layout: "yyyy-MM-dd HH:mm:ss", style: LayoutJava, expect: "2006-01-02 15:04:05"},
+    {name: "java quoted literal", layout: "yyyy-MM-dd'T'HH:mm:ss.SSSXXX", style: LayoutJava, expect: "2006-01-02T15:04:05.000Z07:00"},
+    {name: "java names", layout: "EEEE, d MMMM yy h:mm a", style: LayoutJava, expect: "Monday, 2 January 06 3:04 PM"},
+    {name: "java short names", layout: "EEE MMM dd Z", style: LayoutJava, expect: "Mon Jan 02 -0700"},
+    {name: "moment datetime", layout: "YYYY-MM-DD HH:mm", style: LayoutMoment, expect: "2006-01-02 15:04"},
+    {name: "moment literal letters", layout: "YYYY-MM-DDTHH:mm:ss.SSSZ", style: LayoutMoment, expect: "2006-01-02T15:04:05.000-07:00"},
+    {name: "moment escaped literal", layout: "dddd [at] h:mm a", style: LayoutMoment, expect: "Monday at 3:04 pm"},
+    {name: "moment short", layout: "ddd, D MMM YY", style: LayoutMoment, expect: "Mon, 2 Jan 06"},
+  }
+
+  for _, tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      res, err := TranslateLayout(tt.layout, tt.style)
+      assert.Nil(t, err)
+      assert.Equal(t, tt.expect, res)
+    })
+  }
+
+  t.Run("unsupported layouts", func(t *testing.T) {
+    invalid := []struct {
+      layout string
+      style  LayoutStyle
+    }{
+      {layout: "%Y week %U", style: LayoutStrftime},
+      {layout: "%Y-%m-%d day 1", style: LayoutStrftime},
+      {layout: "%S%f", style: LayoutStrftime},
+      {layout: "%", style: LayoutStrftime},
+      {layout: "yyyy-MM-ddTHH:mm", style: LayoutJava},
+      {layout: "yyyy 'Q1'", style: LayoutJava},
+      {layout: "yyyy-MM-dd'T", style: LayoutJava},
+      {layout: "X", style: LayoutMoment},
+      {layout: "Do MMMM", style: LayoutMoment},
+      {layout: "YYYY", style: LayoutStyle(99)},
+    }
+    for _, tt := range invalid {
+      _, err := TranslateLayout(tt.layout, tt.style)
+      if assert.NotNil(t, err, tt.layout) {
+        assert.True(t, err.Has(ErrorConvertorInvalidLayout))
+      }
+    }
+  })
+}
+
+func TestUnit_ForeignTimeLayouts(t *testing.T) {
+  expect := time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC)
+
+  t.Run("Time", func(t *testing.T) {
+    res, err := Time("02/01/2023 15:04:05", ConvertOptions{TimeLayout: "%d/%m/%Y %H:%M:%S", TimeLayoutStyle: LayoutStrftime})
+    assert.Nil(t, err)
+    assert.Equal(t, expect, res)
+
+    res, err = Time("2023-01-02T15:04:05", ConvertOptions{TimeLayout: "yyyy-MM-dd'T'HH:mm:ss", TimeLayoutStyle: LayoutJava})
+    assert.Nil(t, err)
+    assert.Equal(t, expect, res)
+
+    res, err = Time("Jan 2, 2023 3:04:05 PM", ConvertOptions{TimeLayout: "MMM D, YYYY h:mm:ss A", TimeLayoutStyle: LayoutMoment})
+    assert.Nil(t, err)
+    assert.Equal(t, expect, res)
+
+    _, err = Time("2023", ConvertOptions{TimeLayout: "%Q", TimeLayoutStyle: LayoutStrftime})
+    if assert.NotNil(t, err) {
+      assert.True(t, err.Has(ErrorConvertorInvalidLayout))
+    }
+  })
+
+  t.Run("FormatTime", func(t *testing.T) {
+    res, err := FormatTime(expect, "%A %d %B %Y, %I:%M %p", ConvertOptions{TimeLayoutStyle: LayoutStrftime})
+    assert.Nil(t, err)
+    assert.Equal(t, "Monday 02 January 2023, 03:04 PM", res)
+
+    res, err = FormatTime("2023-01-02T15:04:05Z", "dd.MM.yyyy HH:mm", ConvertOptions{TimeLayoutStyle: LayoutJava})
+    assert.Nil(t, err)
+    assert.Equal(t, "02.01.2023 15:04", res)
+
+    res, err = FormatTime(expect, time.Kitchen)
+    assert.Nil(t, err)
+    assert.Equal(t, "3:04PM", res)
+
+    res, err = FormatTime(expect, "", ConvertOptions{TimeLayout: "YYYY/MM/DD", TimeLayoutStyle: LayoutMoment})
+    assert.Nil(t, err)
+    assert.Equal(t, "2023/01/02", res)
+
+    res, err = FormatTime(expect, "")
+    assert.Nil(t, err)
+    str, _ := String(expect)
+    assert.Equal(t, str, res)
+
+    _, err = FormatTime("banana", "%Y", ConvertOptions{TimeLayoutStyle: LayoutStrftime})
+    assert.NotNil(t, err)
+  })
+
+  t.Run("ToStruct", func(t *testing.T) {
+    dst := struct {
+      Created time.Time `json:"created"`
+    }{}
+    config := DefaultParserConfig
+    config.TimeLayout = "%d.%m.%Y %H:%M:%S"
+    config.TimeLayoutStyle = LayoutStrftime
+    err := ToStruct(&dst, config, map[string]any{"created": "02.01.2023 15:04:05"})
+    assert.Nil(t, err)
+    assert.Equal(t, expect, dst.Created)
+  })
+}
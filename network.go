@@ -0,0 +1,259 @@
+package zgen
+
+import (
+  "github.com/znxlc/zerror"
+  "net"
+  "net/netip"
+  "net/url"
+  "reflect"
+  "strings"
+)
+
+// IP - tries to convert any to net.IP
+// accepts address strings, 4 or 16 raw bytes, netip.Addr and integers for IPv4 addresses (3232235777 is 192.168.1.1)
+func IP(src any, opts ...ConvertOptions) (dst net.IP, err zerror.Error) {
+  if src == nil {
+    return nil, nil
+  }
+  opt := getConvertOptions(opts)
+  if res, found, zer := convertRegistered[net.IP](src, opt.Converters); found { // custom registered converter
+    if zer != nil {
+      return nil, zer
+    }
+    return res, nil
+  }
+  addr, err := ipAddrValue(src, "net.IP", opt)
+  if err != nil {
+    return nil, err
+  }
+  if !addr.IsValid() {
+    return nil, nil
+  }
+  return net.IP(addr.AsSlice()), nil
+}
+
+// IPAddr - tries to convert any to netip.Addr, accepts the same sources as IP
+func IPAddr(src any, opts ...ConvertOptions) (dst netip.Addr, err zerror.Error) {
+  if src == nil {
+    return netip.Addr{}, nil
+  }
+  opt := getConvertOptions(opts)
+  if res, found, zer := convertRegistered[netip.Addr](src, opt.Converters); found { // custom registered converter
+    if zer != nil {
+      return netip.Addr{}, zer
+    }
+    return res, nil
+  }
+  return ipAddrValue(src, "netip.Addr", opt)
+}
+
+// ipAddrValue - converts the IP and IPAddr sources to netip.Addr, dstType is used in the errors
+func ipAddrValue(src any, dstType string, opt ConvertOptions) (dst netip.Addr, err zerror.Error) {
+  switch val := src.(type) {
+  case netip.Addr:
+    return val, nil
+  case net.IP:
+    if len(val) == 0 {
+      return netip.Addr{}, nil
+    }
+    if ipv4 := val.To4(); ipv4 != nil { // net.IP keeps IPv4 addresses in the 16 byte form
+      val = ipv4
+    }
+    if addr, ok := netip.AddrFromSlice(val); ok {
+      return addr, nil
+    }
+  case [4]byte:
+    return netip.AddrFrom4(val), nil
+  case [16]byte:
+    return netip.AddrFrom16(val), nil
+  case []byte:
+    if addr, er := netip.ParseAddr(string(val)); er == nil {
+      return addr, nil
+    }
+    if addr, ok := netip.AddrFromSlice(val); ok { // raw 4 or 16 byte addresses
+      return addr, nil
+    }
+    return ipAddrValue(string(val), dstType, opt)
+  case string:
+    addr, er := netip.ParseAddr(strings.TrimSpace(val))
+    if er != nil {
+      return netip.Addr{}, networkParseError(val, dstType, er)
+    }
+    return addr, nil
+  case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+    ipv4, err := Uint32(val, opt)
+    if err != nil {
+      return netip.Addr{}, err
+    }
+    return netip.AddrFrom4([4]byte{byte(ipv4 >> 24), byte(ipv4 >> 16), byte(ipv4 >> 8), byte(ipv4)}), nil
+  }
+  return netip.Addr{}, networkTypeError(src, dstType)
+}
+
+// IPPrefix - tries to convert any to netip.Prefix
+// accepts CIDR strings ("10.0.0.0/8"), *net.IPNet and single addresses, which become host prefixes (/32 or /128)
+func IPPrefix(src any, opts ...ConvertOptions) (dst netip.Prefix, err zerror.Error) {
+  if src == nil {
+    return netip.Prefix{}, nil
+  }
+  opt := getConvertOptions(opts)
+  if res, found, zer := convertRegistered[netip.Prefix](src, opt.Converters); found { // custom registered converter
+    if zer != nil {
+      return netip.Prefix{}, zer
+    }
+    return res, nil
+  }
+  switch val := src.(type) {
+  case netip.Prefix:
+    return val, nil
+  case *net.IPNet:
+    if val == nil {
+      return netip.Prefix{}, nil
+    }
+    return IPPrefix(val.String(), opt)
+  case net.IPNet:
+    return IPPrefix(val.String(), opt)
+  case []byte:
+    return IPPrefix(string(val), opt)
+  case string:
+    val = strings.TrimSpace(val)
+    if !strings.Contains(val, "/") {
+      break
+    }
+    prefix, er := netip.ParsePrefix(val)
+    if er != nil {
+      return netip.Prefix{}, networkParseError(val, "netip.Prefix", er)
+    }
+    return prefix, nil
+  }
+
+  addr, err := ipAddrValue(src, "netip.Prefix", opt)
+  if err != nil {
+    return netip.Prefix{}, err
+  }
+  return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// HardwareAddr - tries to convert any to net.HardwareAddr (MAC addresses), from strings in the formats of net.ParseMAC or raw bytes
+func HardwareAddr(src any, opts ...ConvertOptions) (dst net.HardwareAddr, err zerror.Error) {
+  if src == nil {
+    return nil, nil
+  }
+  opt := getConvertOptions(opts)
+  if res, found, zer := convertRegistered[net.HardwareAddr](src, opt.Converters); found { // custom registered converter
+    if zer != nil {
+      return nil, zer
+    }
+    return res, nil
+  }
+  switch val := src.(type) {
+  case net.HardwareAddr:
+    return val, nil
+  case []byte:
+    if mac, er := net.ParseMAC(string(val)); er == nil {
+      return mac, nil
+    }
+    switch len(val) {
+    case 6, 8, 20: // raw EUI-48, EUI-64 and 20 octet IP over InfiniBand addresses
+      return net.HardwareAddr(append([]byte{}, val...)), nil
+    }
+    return HardwareAddr(string(val), opt)
+  case string:
+    mac, er := net.ParseMAC(strings.TrimSpace(val))
+    if er != nil {
+      return nil, networkParseError(val, "net.HardwareAddr", er)
+    }
+    return mac, nil
+  }
+  return nil, networkTypeError(src, "net.HardwareAddr")
+}
+
+// URL - tries to convert any to *url.URL, from strings and []byte using url.Parse
+func URL(src any, opts ...ConvertOptions) (dst *url.URL, err zerror.Error) {
+  if src == nil {
+    return nil, nil
+  }
+  opt := getConvertOptions(opts)
+  if res, found, zer := convertRegistered[*url.URL](src, opt.Converters); found { // custom registered converter
+    if zer != nil {
+      return nil, zer
+    }
+    return res, nil
+  }
+  switch val := src.(type) {
+  case *url.URL:
+    if val == nil {
+      return nil, nil
+    }
+    dst := *val
+    if val.User != nil {
+      user := *val.User
+      dst.User = &user
+    }
+    return &dst, nil
+  case url.URL:
+    return URL(&val, opt)
+  case []byte:
+    return URL(string(val), opt)
+  case string:
+    dst, er := url.Parse(strings.TrimSpace(val))
+    if er != nil {
+      return nil, networkParseError(val, "*url.URL", er)
+    }
+    return dst, nil
+  }
+  return nil, networkTypeError(src, "*url.URL")
+}
+
+// networkParseError - returns the error for strings that can not be parsed as the network type
+func networkParseError(src string, dstType string, er error) zerror.Error {
+  return zerror.New(ErrorConvertorTypeNotSupported, map[string]any{
+    "error":    er.Error(),
+    "src":      src,
+    "src_type": "string",
+    "dst_type": dstType,
+  })
+}
+
+// networkTypeError - returns the error for unsupported sources
+func networkTypeError(src any, dstType string) zerror.Error {
+  return zerror.New(ErrorConvertorTypeNotSupported, map[string]any{
+    "src":      src,
+    "src_type": reflect.TypeOf(src).String(),
+    "dst_type": dstType,
+  })
+}
+
+// networkString - String for the network types, empty and invalid values return an empty string instead of "<nil>" or "invalid IP"
+func networkString(src any) string {
+  switch val := src.(type) {
+  case net.IP:
+    if len(val) == 0 {
+      return ""
+    }
+    return val.String()
+  case netip.Addr:
+    if !val.IsValid() {
+      return ""
+    }
+    return val.String()
+  case netip.Prefix:
+    if !val.IsValid() {
+      return ""
+    }
+    return val.String()
+  case net.HardwareAddr:
+    return val.String()
+  case *net.IPNet:
+    if val == nil {
+      return ""
+    }
+    return val.String()
+  case *url.URL:
+    if val == nil {
+      return ""
+    }
+    return val.String()
+  }
+  return ""
+}
@@ -0,0 +1,60 @@
+package zgen
+
+import (
+  "github.com/znxlc/zerror"
+  "math"
+  "math/big"
+  "reflect"
+  "strconv"
+  "strings"
+)
+
+// nonFiniteValue - returns the NaN or infinite value of src, ok is false for finite values and sources that are not numbers
+// complex numbers use their real part (the part kept by the converters), strings are parsed with strconv.ParseFloat ("NaN", "inf", "-Infinity")
+func nonFiniteValue(src any) (val float64, ok bool) {
+  switch typed := src.(type) {
+  case float32:
+    val = float64(typed)
+  case float64:
+    val = typed
+  case complex64:
+    val = float64(real(typed))
+  case complex128:
+    val = real(typed)
+  case *big.Float:
+    if typed == nil || !typed.IsInf() {
+      return 0, false
+    }
+    return math.Inf(typed.Sign()), true
+  case []byte:
+    return nonFiniteValue(string(typed))
+  case string:
+    floatVal, er := strconv.ParseFloat(strings.TrimSpace(typed), 64)
+    if er != nil { // out of range numbers ("1e400") are overflows, not infinite values
+      return 0, false
+    }
+    val = floatVal
+  default:
+    return 0, false
+  }
+  return val, math.IsNaN(val) || math.IsInf(val, 0)
+}
+
+// applyNonFinite - applies the ConvertOptions.NonFinite policy to NaN and infinite sources
+// returns ErrorConvertorNonFinite with NonFiniteReject and nil with NonFiniteZero (the converters return their nil value), other sources are returned as is
+func applyNonFinite(src any, dstType string, opt ConvertOptions) (dst any, err zerror.Error) {
+  if opt.NonFinite != NonFiniteReject && opt.NonFinite != NonFiniteZero {
+    return src, nil
+  }
+  if _, ok := nonFiniteValue(src); !ok {
+    return src, nil
+  }
+  if opt.NonFinite == NonFiniteZero {
+    return nil, nil
+  }
+  return src, zerror.New(ErrorConvertorNonFinite, map[string]any{
+    "src":      src,
+    "src_type": reflect.TypeOf(src).String(),
+    "dst_type": dstType,
+  })
+}
@@ -0,0 +1,149 @@
+package zgen
+
+import (
+  "math"
+  "math/big"
+  "testing"
+
+  "github.com/stretchr/testify/assert"
+)
+
+func TestUnit_NonFiniteValue(t *testing.T) {
+  tests := []struct {
+    name   string
+    input  any
+    expect float64
+    ok     bool
+  }{
+    {name: "NaN", input: math.NaN(), expect: math.NaN(), ok: true},
+    {name: "float32 infinite", input: float32(math.Inf(-1)), expect: math.Inf(-1), ok: true},
+    {name: "complex real part", input: complex(math.Inf(1), 2), expect: math.Inf(1), ok: true},
+    {name: "complex imaginary part", input: complex(1, math.NaN()), ok: false},
+    {name: "infinite big float", input: new(big.Float).SetInf(true), expect: math.Inf(-1), ok: true},
+    {name: "string NaN", input: " nan ", expect: math.NaN(), ok: true},
+    {name: "string infinity", input: "-Infinity", expect: math.Inf(-1), ok: true},
+    {name: "bytes inf", input: []byte("+Inf"), expect: math.Inf(1), ok: true},
+    {name: "out of range string", input: "1e400", ok: false},
+    {name: "finite float", input: 1.5, ok: false},
+    {name: "integer", input: 5, ok: false},
+    {name: "word", input: "banana", ok: false},
+  }
+
+  for _, tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      res, ok := nonFiniteValue(tt.input)
+      assert.Equal(t, tt.ok, ok)
+      if !tt.ok {
+        return
+      }
+      if math.IsNaN(tt.expect) {
+        assert.True(t, math.IsNaN(res))
+      } else {
+        assert.Equal(t, tt.expect, res)
+      }
+    })
+  }
+}
+
+func TestUnit_NonFinitePolicy(t *testing.T) {
+  allow := ConvertOptions{NonFinite: NonFiniteAllow}
+  reject := ConvertOptions{NonFinite: NonFiniteReject}
+  zero := ConvertOptions{NonFinite: NonFiniteZero, NilString: "null"}
+
+  tests := []struct {
+    name      string
+    convert   func() (any, error)
+    expect    any
+    errorCode string
+  }{
+    // default behavior
+    {name: "default float64 keeps float64", convert: func() (any, error) { return Float64(math.Inf(1)) }, expect: math.Inf(1)},
+    {name: "default float64 from string", convert: func() (any, error) { return Float64("inf") }, errorCode: ErrorConvertorNumberOverflow},
+    {name: "default int from string", convert: func() (any, error) { return Int("NaN") }, errorCode: ErrorConvertorNumberOverflow},
+    {name: "default int64 from bytes", convert: func() (any, error) { return Int64([]byte("-inf")) }, errorCode: ErrorConvertorNumberOverflow},
+    {name: "default saturate", convert: func() (any, error) { return Int8("-Inf", ConvertOptions{Overflow: OverflowSaturate}) }, expect: int8(math.MinInt8)},
+    {name: "default saturate NaN", convert: func() (any, error) { return Int8(math.NaN(), ConvertOptions{Overflow: OverflowSaturate}) }, errorCode: ErrorConvertorNumberOverflow},
+    {name: "default decimal from string", convert: func() (any, error) { return Decimal("NaN") }, errorCode: ErrorConvertorNumberOverflow},
+    {name: "default string", convert: func() (any, error) { return String(math.Inf(-1)) }, expect: "-Inf"},
+    {name: "default bool", convert: func() (any, error) { return Bool(math.NaN()) }, errorCode: ErrorConvertorNumberOverflow},
+    {name: "default bool from string", convert: func() (any, error) { return Bool("inf") }, errorCode: ErrorConvertorNumberOverflow},
+    {name: "default bool from NaN string", convert: func() (any, error) { return Bool("NaN") }, errorCode: ErrorConvertorNumberOverflow},
+    {name: "default bool from complex", convert: func() (any, error) { return Bool(complex(math.Inf(-1), 0)) }, errorCode: ErrorConvertorNumberOverflow},
+
+    // allow
+    {name: "allow float64 from string", convert: func() (any, error) { return Float64("-Infinity", allow) }, expect: math.Inf(-1)},
+    {name: "allow float64 from float32", convert: func() (any, error) { return Float64(float32(math.Inf(1)), allow) }, expect: math.Inf(1)},
+    {name: "allow float32 from float64", convert: func() (any, error) { return Float32(math.Inf(1), allow) }, expect: float32(math.Inf(1))},
+    {name: "allow float32 from big float", convert: func() (any, error) { return Float32(new(big.Float).SetInf(true), allow) }, expect: float32(math.Inf(-1))},
+    {name: "allow int", convert: func() (any, error) { return Uint16(math.Inf(1), allow) }, errorCode: ErrorConvertorNumberOverflow},
+    {name: "allow decimal", convert: func() (any, error) { return Decimal(math.Inf(1), allow) }, errorCode: ErrorConvertorNumberOverflow},
+    {name: "allow bool", convert: func() (any, error) { return Bool("nan", allow) }, expect: true},
+    {name: "allow string", convert: func() (any, error) { return StringWithOptions(math.Inf(1), allow) }, expect: "+Inf"},
+
+    // reject
+    {name: "reject float64", convert: func() (any, error) { return Float64(math.NaN(), reject) }, errorCode: ErrorConvertorNonFinite},
+    {name: "reject float32 from string", convert: func() (any, error) { return Float32("inf", reject) }, errorCode: ErrorConvertorNonFinite},
+    {name: "reject int", convert: func() (any, error) { return Int(math.Inf(1), reject) }, errorCode: ErrorConvertorNonFinite},
+    {name: "reject uint64 from complex", convert: func() (any, error) { return Uint64(complex(math.NaN(), 0), reject) }, errorCode: ErrorConvertorNonFinite},
+    {name: "reject decimal", convert: func() (any, error) { return Decimal(float32(math.NaN()), reject) }, errorCode: ErrorConvertorNonFinite},
+    {name: "reject string", convert: func() (any, error) { return StringWithOptions(math.NaN(), reject) }, errorCode: ErrorConvertorNonFinite},
+    {name: "reject string keeps text", convert: func() (any, error) { return StringWithOptions("NaN", reject) }, expect: "NaN"},
+    {name: "reject bool", convert: func() (any, error) { return Bool(math.Inf(-1), reject) }, errorCode: ErrorConvertorNonFinite},
+    {name: "reject finite", convert: func() (any, error) { return Float64("1.5", reject) }, expect: 1.5},
+    {name: "reject before strict", convert: func() (any, error) { return Decimal(math.NaN(), ConvertOptions{NonFinite: NonFiniteReject, Strict: true}) }, errorCode: ErrorConvertorNonFinite},
+
+    // zero
+    {name: "zero float64", convert: func() (any, error) { return Float64(math.NaN(), zero) }, expect: float64(0)},
+    {name: "zero float32", convert: func() (any, error) { return Float32("-inf", zero) }, expect: float32(0)},
+    {name: "zero int", convert: func() (any, error) { return Int32(math.Inf(1), zero) }, expect: int32(0)},
+    {name: "zero decimal", convert: func() (any, error) { return Decimal("NaN", zero) }, expect: "0"},
+    {name: "zero string", convert: func() (any, error) { return StringWithOptions(math.Inf(1), zero) }, expect: "null"},
+    {name: "zero bool", convert: func() (any, error) { return Bool(math.NaN(), zero) }, expect: false},
+  }
+
+  for _, tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      res, err := tt.convert()
+      if tt.errorCode != "" {
+        if assert.NotNil(t, err) {
+          assert.True(t, err.(interface{ Has(string) bool }).Has(tt.errorCode), "Expected error code '%s' but got '%s'", tt.errorCode, err.Error())
+        }
+        return
+      }
+      assert.Nil(t, err)
+      if stringer, ok := res.(interface{ String() string }); ok { // decimals
+        res = stringer.String()
+      }
+      assert.Equal(t, tt.expect, res)
+    })
+  }
+}
+
+func TestUnit_NonFiniteToStruct(t *testing.T) {
+  dst := struct {
+    Ratio float64 `json:"ratio"`
+    Count int     `json:"count"`
+    Label string  `json:"label"`
+  }{}
+  data := map[string]any{"ratio": "NaN", "count": math.Inf(1), "label": math.Inf(-1)}
+
+  config := DefaultParserConfig
+  config.NonFinite = NonFiniteZero
+  config.NilString = "n/a"
+  err := ToStruct(&dst, config, data)
+  assert.Nil(t, err)
+  assert.Equal(t, float64(0), dst.Ratio)
+  assert.Equal(t, 0, dst.Count)
+  assert.Equal(t, "n/a", dst.Label)
+
+  config.NonFinite = NonFiniteReject
+  err = ToStruct(&dst, config, map[string]any{"ratio": "NaN"})
+  if assert.NotNil(t, err) {
+    assert.True(t, err.Has(ErrorConvertorNonFinite))
+  }
+
+  config.NonFinite = NonFiniteAllow
+  err = ToStruct(&dst, config, map[string]any{"ratio": "-inf"})
+  assert.Nil(t, err)
+  assert.True(t, math.IsInf(dst.Ratio, -1))
+}
@@ -0,0 +1,219 @@
+package zgen
+
+import (
+  "errors"
+  "github.com/znxlc/zerror"
+  "strings"
+
+  "github.com/shopspring/decimal"
+)
+
+// NumberFormat - locale number format used by the numeric converters to parse formatted strings ("1.234,56", "$1,234.56", "12,5%")
+// and by FormatNumber to write them
+type NumberFormat struct {
+  DecimalSeparator string   `json:"decimal_separator"` // decimal separator, "." if empty
+  GroupSeparator   string   `json:"group_separator"`   // thousands separator, empty disables grouping
+  Currency         string   `json:"currency"`          // currency symbol written by FormatNumber, also accepted when parsing
+  CurrencySymbols  []string `json:"currency_symbols"`  // additional currency symbols or codes accepted when parsing (e.g. "€", "EUR")
+  CurrencySuffix   bool     `json:"currency_suffix"`   // FormatNumber writes the currency after the number ("1.234,56 €")
+  Percent          bool     `json:"percent"`           // parsing accepts a trailing "%" and divides the value by 100, FormatNumber multiplies by 100 and appends "%"
+  Precision        *int     `json:"precision"`         // number of decimals written by FormatNumber (rounded half away from zero), nil writes all the decimals
+}
+
+var (
+  // NumberFormatUS - "1,234.56"
+  NumberFormatUS = NumberFormat{DecimalSeparator: ".", GroupSeparator: ","}
+  // NumberFormatEU - "1.234,56"
+  NumberFormatEU = NumberFormat{DecimalSeparator: ",", GroupSeparator: "."}
+)
+
+// decimalSeparator - returns the decimal separator or the default "."
+func (f NumberFormat) decimalSeparator() string {
+  if f.DecimalSeparator == "" {
+    return "."
+  }
+  return f.DecimalSeparator
+}
+
+// validate - returns ErrorConvertorInvalidNumberFormat if the group separator is the decimal separator, the numbers would be ambiguous
+func (f NumberFormat) validate() zerror.Error {
+  if f.GroupSeparator != "" && f.GroupSeparator == f.decimalSeparator() {
+    return zerror.New(ErrorConvertorInvalidNumberFormat, map[string]any{
+      "decimal_separator": f.decimalSeparator(),
+      "group_separator":   f.GroupSeparator,
+    })
+  }
+  return nil
+}
+
+// trimCurrency - removes a currency symbol from the start or the end of val
+func (f NumberFormat) trimCurrency(val string) string {
+  symbols := f.CurrencySymbols
+  if f.Currency != "" {
+    symbols = append([]string{f.Currency}, symbols...)
+  }
+  for _, symbol := range symbols {
+    if symbol == "" {
+      continue
+    }
+    if strings.HasPrefix(val, symbol) {
+      return strings.TrimSpace(strings.TrimPrefix(val, symbol))
+    }
+    if strings.HasSuffix(val, symbol) {
+      return strings.TrimSpace(strings.TrimSuffix(val, symbol))
+    }
+  }
+  return val
+}
+
+// Parse - converts a formatted number string to the canonical format accepted by strconv and decimal ("-1234.56")
+// ok is false if val does not match the format or the format is ambiguous (same group and decimal separators)
+func (f NumberFormat) Parse(val string) (dst string, ok bool) {
+  if f.validate() != nil {
+    return "", false
+  }
+  val = strings.TrimSpace(val)
+  percent := false
+  if f.Percent && strings.HasSuffix(val, "%") {
+    percent = true
+    val = strings.TrimSpace(strings.TrimSuffix(val, "%"))
+  }
+
+  sign := ""
+  for idx := 0; idx < 2; idx++ { // the sign can be placed before or after the currency ("-$5", "$-5")
+    if strings.HasPrefix(val, "-") || strings.HasPrefix(val, "+") {
+      if sign != "" {
+        return "", false
+      }
+      sign = val[:1]
+      val = strings.TrimSpace(val[1:])
+    }
+    if idx == 0 {
+      val = f.trimCurrency(val)
+    }
+  }
+  if val == "" {
+    return "", false
+  }
+
+  intPart, fraction, hasFraction := strings.Cut(val, f.decimalSeparator())
+  if hasFraction && (fraction == "" || strings.Contains(fraction, f.decimalSeparator())) {
+    return "", false
+  }
+  if f.GroupSeparator != "" {
+    if hasFraction && strings.Contains(fraction, f.GroupSeparator) {
+      return "", false
+    }
+    if strings.Contains(intPart, f.GroupSeparator) { // grouped digits, the first group has 1 to 3 digits and the others exactly 3
+      groups := strings.Split(intPart, f.GroupSeparator)
+      if len(groups[0]) == 0 || len(groups[0]) > 3 {
+        return "", false
+      }
+      for _, group := range groups[1:] {
+        if len(group) != 3 {
+          return "", false
+        }
+      }
+      intPart = strings.Join(groups, "")
+    }
+  }
+  if strings.ContainsAny(intPart, "+-") { // the sign is only accepted at the start
+    return "", false
+  }
+
+  dst = sign + intPart
+  if hasFraction {
+    dst += "." + fraction
+  }
+  dec, er := decimal.NewFromString(dst) // validates the number
+  if er != nil {
+    return "", false
+  }
+  if percent {
+    dst = dec.Shift(-2).String()
+  }
+  return dst, true
+}
+
+// applyNumberFormat - converts formatted number strings (and []byte) to the canonical format when a NumberFormat is set
+// strings that do not match the format return ErrorConvertorInvalidSyntax, the canonical parsers would read them with another meaning ("1.500" is 1.5 instead of 1500)
+func applyNumberFormat(src any, format *NumberFormat, dstType string) (dst any, err zerror.Error) {
+  if format == nil {
+    return src, nil
+  }
+  var val string
+  switch typed := src.(type) {
+  case string:
+    val = typed
+  case []byte:
+    val = string(typed)
+  default:
+    return src, nil
+  }
+  if err = format.validate(); err != nil {
+    return src, err
+  }
+  res, ok := format.Parse(val)
+  if !ok {
+    return src, strictInvalidSyntaxError(src, dstType, errors.New("the number does not match the number format"))
+  }
+  return res, nil
+}
+
+// FormatNumber - writes any number (or numeric string) using the number format, ambiguous formats return ErrorConvertorInvalidNumberFormat
+// example:
+//
+//	cents := 2
+//	FormatNumber(1234.5, NumberFormat{DecimalSeparator: ",", GroupSeparator: ".", Currency: "€", CurrencySuffix: true, Precision: &cents}) // "1.234,50 €"
+func FormatNumber(src any, format NumberFormat) (dst string, err zerror.Error) {
+  if err = format.validate(); err != nil {
+    return "", err
+  }
+  dec, err := Decimal(src)
+  if err != nil {
+    return "", err
+  }
+  if format.Percent {
+    dec = dec.Shift(2)
+  }
+  res := dec.String()
+  if format.Precision != nil {
+    res = dec.StringFixed(int32(*format.Precision))
+  }
+
+  sign := ""
+  if strings.HasPrefix(res, "-") {
+    sign = "-"
+    res = res[1:]
+  }
+  intPart, fraction, hasFraction := strings.Cut(res, ".")
+  if format.GroupSeparator != "" && len(intPart) > 3 {
+    var grouped strings.Builder
+    first := len(intPart) % 3
+    if first == 0 {
+      first = 3
+    }
+    grouped.WriteString(intPart[:first])
+    for idx := first; idx < len(intPart); idx += 3 {
+      grouped.WriteString(format.GroupSeparator)
+      grouped.WriteString(intPart[idx : idx+3])
+    }
+    intPart = grouped.String()
+  }
+
+  res = intPart
+  if hasFraction {
+    res += format.decimalSeparator() + fraction
+  }
+  if format.Percent {
+    res += "%"
+  }
+  if format.Currency != "" {
+    if format.CurrencySuffix {
+      res += " " + format.Currency
+    } else {
+      res = format.Currency + res
+    }
+  }
+  return sign + res, nil
+}
@@ -0,0 +1,171 @@
+package zgen
+
+import (
+  "testing"
+
+  "github.com/shopspring/decimal"
+  "github.com/stretchr/testify/assert"
+)
+
+func TestUnit_NumberFormatParse(t *testing.T) {
+  euro := NumberFormat{DecimalSeparator: ",", GroupSeparator: ".", Currency: "€", CurrencySymbols: []string{"EUR"}, Percent: true}
+
+  tests := []struct {
+    name   string
+    format NumberFormat
+    input  string
+    expect string
+    ok     bool
+  }{
+    {name: "us grouped", format: NumberFormatUS, input: "1,234.56", expect: "1234.56", ok: true},
+    {name: "eu grouped", format: NumberFormatEU, input: "1.234,56", expect: "1234.56", ok: true},
+    {name: "eu without grouping", format: NumberFormatEU, input: "1234,5", expect: "1234.5", ok: true},
+    {name: "negative", format: NumberFormatEU, input: "-1.234.567", expect: "-1234567", ok: true},
+    {name: "currency prefix", format: NumberFormat{GroupSeparator: ",", Currency: "$"}, input: "-$1,234.56", expect: "-1234.56", ok: true},
+    {name: "currency after sign", format: NumberFormat{GroupSeparator: ",", Currency: "$"}, input: "$-5", expect: "-5", ok: true},
+    {name: "currency suffix", format: euro, input: "1.234,56 €", expect: "1234.56", ok: true},
+    {name: "currency code", format: euro, input: "EUR 12,5", expect: "12.5", ok: true},
+    {name: "percent", format: euro, input: "12,5%", expect: "0.125", ok: true},
+    {name: "percent disabled", format: NumberFormatEU, input: "12,5%", ok: false},
+    {name: "us string with eu format", format: NumberFormatEU, input: "1,234.56", ok: false},
+    {name: "eu string with us format", format: NumberFormatUS, input: "1.234,56", ok: false},
+    {name: "invalid grouping", format: NumberFormatUS, input: "12,34", ok: false},
+    {name: "double sign", format: NumberFormatUS, input: "--5", ok: false},
+    {name: "empty", format: NumberFormatUS, input: "$", ok: false},
+  }
+
+  for _, tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      res, ok := tt.format.Parse(tt.input)
+      assert.Equal(t, tt.ok, ok)
+      if tt.ok {
+        assert.Equal(t, tt.expect, res)
+      }
+    })
+  }
+}
+
+func TestUnit_NumberFormatConverters(t *testing.T) {
+  eu := ConvertOptions{NumberFormat: &NumberFormat{DecimalSeparator: ",", GroupSeparator: ".", Currency: "€", Percent: true}}
+  us := ConvertOptions{NumberFormat: &NumberFormatUS}
+
+  floatRes, err := Float64("1.234,56", eu)
+  assert.Nil(t, err)
+  assert.Equal(t, 1234.56, floatRes)
+
+  float32Res, err := Float32([]byte("1,234.5"), us)
+  assert.Nil(t, err)
+  assert.Equal(t, float32(1234.5), float32Res)
+
+  decimalRes, err := Decimal("€ 1.234,56", eu)
+  assert.Nil(t, err)
+  assert.Equal(t, "1234.56", decimalRes.String())
+
+  decimalRes, err = Decimal("12,5%", eu)
+  assert.Nil(t, err)
+  assert.Equal(t, "0.125", decimalRes.String())
+
+  intRes, err := Int("1,234,567", us)
+  assert.Nil(t, err)
+  assert.Equal(t, 1234567, intRes)
+
+  uintRes, err := Uint16("1.234,9", ConvertOptions{NumberFormat: &NumberFormatEU, Rounding: RoundHalfUp})
+  assert.Nil(t, err)
+  assert.Equal(t, uint16(1235), uintRes)
+
+  complexRes, err := Complex128("1.500,25", eu)
+  assert.Nil(t, err)
+  assert.Equal(t, complex(1500.25, 0), complexRes)
+
+  _, err = Float64("1,234.56", eu)
+  assert.NotNil(t, err)
+
+  for _, input := range []any{"1.50", "1,234.5", []byte("1.50")} {
+    _, err = Float64(input, ConvertOptions{NumberFormat: &NumberFormatEU})
+    if assert.NotNil(t, err, "strings that do not match the number format are rejected") {
+      assert.True(t, err.Has(ErrorConvertorInvalidSyntax))
+    }
+  }
+
+  _, err = Int("1.50", ConvertOptions{NumberFormat: &NumberFormatEU})
+  assert.NotNil(t, err)
+
+  _, err = Float64("1,234.56")
+  assert.NotNil(t, err, "the canonical format is required without a NumberFormat")
+}
+
+func TestUnit_NumberFormatToStruct(t *testing.T) {
+  dst := struct {
+    Price    decimal.Decimal `json:"price"`
+    Quantity int             `json:"quantity"`
+    Ratio    float64         `json:"ratio"`
+  }{}
+
+  config := DefaultParserConfig
+  config.NumberFormat = &NumberFormatEU
+  err := ToStruct(&dst, config, map[string]any{"price": "1.299,90", "quantity": "2.000", "ratio": "0,75"})
+  assert.Nil(t, err)
+  assert.Equal(t, "1299.9", dst.Price.String())
+  assert.Equal(t, 2000, dst.Quantity)
+  assert.Equal(t, 0.75, dst.Ratio)
+}
+
+func TestUnit_FormatNumber(t *testing.T) {
+  zero, two := 0, 2
+  tests := []struct {
+    name   string
+    input  any
+    format NumberFormat
+    expect string
+  }{
+    {name: "us", input: 1234567.891, format: NumberFormatUS, expect: "1,234,567.891"},
+    {name: "eu", input: 1234567.891, format: NumberFormatEU, expect: "1.234.567,891"},
+    {name: "negative int", input: -1234, format: NumberFormatUS, expect: "-1,234"},
+    {name: "small number", input: 123, format: NumberFormatEU, expect: "123"},
+    {name: "no grouping", input: 1234.5, format: NumberFormat{DecimalSeparator: ","}, expect: "1234,5"},
+    {name: "precision", input: 1234.5, format: NumberFormat{GroupSeparator: ",", Precision: &two}, expect: "1,234.50"},
+    {name: "currency prefix", input: -1234.5, format: NumberFormat{GroupSeparator: ",", Currency: "$", Precision: &two}, expect: "-$1,234.50"},
+    {name: "currency suffix", input: "1234.5", format: NumberFormat{DecimalSeparator: ",", GroupSeparator: ".", Currency: "€", CurrencySuffix: true, Precision: &two}, expect: "1.234,50 €"},
+    {name: "zero decimals", input: 1234.5, format: NumberFormat{GroupSeparator: ",", Currency: "$", Precision: &zero}, expect: "$1,235"},
+    {name: "percent", input: 0.125, format: NumberFormat{DecimalSeparator: ",", Percent: true}, expect: "12,5%"},
+    {name: "decimal", input: decimal.RequireFromString("1000000.01"), format: NumberFormatUS, expect: "1,000,000.01"},
+  }
+
+  for _, tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      res, err := FormatNumber(tt.input, tt.format)
+      assert.Nil(t, err)
+      assert.Equal(t, tt.expect, res)
+
+      parsed, ok := tt.format.Parse(res)
+      assert.True(t, ok, "the formatted value must be parsable with the same format")
+      expect, _ := Decimal(tt.input)
+      if tt.format.Precision == nil {
+        assert.Equal(t, expect.String(), parsed)
+      }
+    })
+  }
+
+  _, err := FormatNumber("banana", NumberFormatUS)
+  assert.NotNil(t, err)
+}
+
+func TestUnit_NumberFormatAmbiguous(t *testing.T) {
+  ambiguous := NumberFormat{DecimalSeparator: ",", GroupSeparator: ","}
+  defaultDecimal := NumberFormat{GroupSeparator: "."}
+
+  _, ok := ambiguous.Parse("1,234")
+  assert.False(t, ok)
+  _, ok = defaultDecimal.Parse("1.234")
+  assert.False(t, ok)
+
+  _, err := Float64("1,234", ConvertOptions{NumberFormat: &ambiguous})
+  if assert.NotNil(t, err) {
+    assert.True(t, err.Has(ErrorConvertorInvalidNumberFormat))
+  }
+
+  _, err = FormatNumber(1234.5, defaultDecimal)
+  if assert.NotNil(t, err) {
+    assert.True(t, err.Has(ErrorConvertorInvalidNumberFormat))
+  }
+}
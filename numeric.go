@@ -0,0 +1,317 @@
+package zgen
+
+import (
+  "github.com/znxlc/zerror"
+  "math"
+  "math/big"
+  "reflect"
+  "strconv"
+  "strings"
+  "time"
+
+  "github.com/shopspring/decimal"
+)
+
+// integer - integer types handled by the integer converters
+type integer interface {
+  ~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// convertInteger - applies the conversion options (registered converters, rounding, strict mode and overflow policy)
+// and converts src using the converter core
+func convertInteger[T integer](src any, dstType string, convert func(any) (T, zerror.Error), opts []ConvertOptions) (dst T, err zerror.Error) {
+  if src == nil {
+    return 0, nil
+  }
+  opt := getConvertOptions(opts)
+  if res, found, zer := convertRegistered[T](src, opt.Converters); found { // custom registered converter
+    if zer != nil {
+      return 0, zer
+    }
+    return res, nil
+  }
+  src = jsonNumberValue(src)
+  src, err = applyNonFinite(src, dstType, opt)
+  if err != nil || src == nil {
+    return 0, err
+  }
+  if val, ok := nonFiniteValue(src); ok { // integers can not represent NaN and infinite values, infinite values saturate with OverflowSaturate
+    if opt.Overflow == OverflowSaturate && !math.IsNaN(val) {
+      minVal, maxVal := integerBounds[T]()
+      return saturate(int(math.Copysign(1, val)), minVal, maxVal), nil
+    }
+    return 0, zerror.New(ErrorConvertorNumberOverflow, map[string]any{
+      "from_type": reflect.TypeOf(src).String(),
+      "to_type":   dstType,
+      "value":     src,
+    })
+  }
+  src, err = applyNumberFormat(src, opt.NumberFormat, dstType)
+  if err != nil {
+    return 0, err
+  }
+  original := src
+  literal, isLiteral := parseIntegerLiteral(src)
+  if isLiteral { // integer strings are parsed directly, without the float round trip
+    src = literal
+  }
+  bigVal, isBig := integerBigValue(src)
+  if isBig { // big numbers are converted to decimals, their range is always checked
+    src = bigVal
+  }
+  src = roundNumber(src, opt.Rounding)
+  if opt.Strict {
+    if err = strictInteger(src, dstType); err != nil {
+      return 0, err
+    }
+  }
+  src, isDecimal := integerDecimalValue(src)
+
+  minVal, maxVal := integerBounds[T]()
+  if opt.Strict || opt.Overflow == OverflowSaturate || isLiteral || isBig || isDecimal { // the converter cores do not check the range for every source type
+    if sign := numberOutOfRange(src, int64(minVal), uint64(maxVal)); sign != 0 {
+      if opt.Overflow == OverflowSaturate {
+        return saturate(sign, minVal, maxVal), nil
+      }
+      return 0, zerror.New(ErrorConvertorNumberOverflow, map[string]any{
+        "from_type": reflect.TypeOf(original).String(),
+        "to_type":   dstType,
+        "value":     original,
+      })
+    }
+  }
+
+  dst, err = convert(src)
+  if err != nil && opt.Overflow == OverflowSaturate && err.Has(ErrorConvertorNumberOverflow) {
+    if sign := numberOutOfRange(src, 0, 0); sign != 0 {
+      return saturate(sign, minVal, maxVal), nil
+    }
+  }
+
+  return dst, err
+}
+
+// parseIntegerLiteral - parses integer strings (and []byte) to int64, or uint64 above the int64 range
+// literals outside of the 64-bit range are returned as *big.Int, the converters report the overflow
+// accepts an optional sign, the 0x, 0o and 0b base prefixes and underscores between digits ("1_000_000")
+// leading zeros without a prefix are decimal ("0755" is 755), ok is false for any other source or format
+func parseIntegerLiteral(src any) (dst any, ok bool) {
+  var val string
+  switch typed := src.(type) {
+  case string:
+    val = typed
+  case []byte:
+    val = string(typed)
+  default:
+    return nil, false
+  }
+
+  val, base, ok := integerLiteral(val)
+  if !ok {
+    return nil, false
+  }
+  if intVal, er := strconv.ParseInt(val, base, 64); er == nil {
+    return intVal, true
+  }
+  if uintVal, er := strconv.ParseUint(strings.TrimPrefix(val, "+"), base, 64); er == nil {
+    return uintVal, true
+  }
+  if bigVal, ok := new(big.Int).SetString(val, base); ok {
+    return bigVal, true
+  }
+  return nil, false
+}
+
+// integerLiteral - prepares an integer string for strconv and big.Int parsing, returns the string and its base
+// prefixed literals keep the prefix (base 0), decimal literals are returned without the underscores
+func integerLiteral(val string) (dst string, base int, ok bool) {
+  digits := strings.TrimLeft(val, "+-")
+  if len(val)-len(digits) > 1 {
+    return "", 0, false
+  }
+  base = 10
+  if len(digits) > 2 && digits[0] == '0' {
+    switch digits[1] {
+    case 'x', 'X', 'o', 'O', 'b', 'B':
+      base = 0 // the parsers read the base from the prefix
+    }
+  }
+  if base == 10 && strings.Contains(val, "_") {
+    if strings.HasPrefix(digits, "_") || strings.HasSuffix(digits, "_") || strings.Contains(digits, "__") {
+      return "", 0, false
+    }
+    val = strings.ReplaceAll(val, "_", "")
+  }
+  return val, base, true
+}
+
+// integerBounds - returns the min and max values of the integer type T
+func integerBounds[T integer]() (minVal T, maxVal T) {
+  maxVal = ^T(0)
+  if maxVal > 0 { // unsigned
+    return 0, maxVal
+  }
+  bits := reflect.TypeOf(maxVal).Bits()
+  maxVal = T(1)<<(bits-1) - 1
+  return -maxVal - 1, maxVal
+}
+
+// saturate - returns the bound matching the sign of the out of range value
+func saturate[T integer](sign int, minVal, maxVal T) T {
+  if sign < 0 {
+    return minVal
+  }
+  return maxVal
+}
+
+// numberOutOfRange - returns -1 if the integer part of src is below minVal, 1 if it is above maxVal
+// and 0 if it is in range, NaN or not a number
+func numberOutOfRange(src any, minVal int64, maxVal uint64) int {
+  switch val := src.(type) {
+  case int, int8, int16, int32, int64, time.Duration:
+    intVal := reflect.ValueOf(val).Int()
+    if intVal < minVal {
+      return -1
+    }
+    if intVal > 0 && uint64(intVal) > maxVal {
+      return 1
+    }
+  case uint, uint8, uint16, uint32, uint64:
+    if reflect.ValueOf(val).Uint() > maxVal {
+      return 1
+    }
+  case float32:
+    return floatOutOfRange(float64(val), minVal, maxVal)
+  case float64:
+    return floatOutOfRange(val, minVal, maxVal)
+  case complex64:
+    return floatOutOfRange(float64(real(val)), minVal, maxVal)
+  case complex128:
+    return floatOutOfRange(real(val), minVal, maxVal)
+  case time.Time:
+    return numberOutOfRange(val.Unix(), minVal, maxVal)
+  case []byte:
+    return numberOutOfRange(string(val), minVal, maxVal)
+  case string:
+    if intVal, er := strconv.ParseInt(val, 10, 64); er == nil {
+      return numberOutOfRange(intVal, minVal, maxVal)
+    }
+    if uintVal, er := strconv.ParseUint(val, 10, 64); er == nil {
+      return numberOutOfRange(uintVal, minVal, maxVal)
+    }
+    if floatVal, er := strconv.ParseFloat(val, 64); er == nil || floatVal != 0 { // ParseFloat returns ±Inf with a range error
+      return floatOutOfRange(floatVal, minVal, maxVal)
+    }
+  }
+  return 0
+}
+
+// floatOutOfRange - numberOutOfRange for floats, the value is truncated toward zero before the comparison
+func floatOutOfRange(val float64, minVal int64, maxVal uint64) int {
+  if math.IsNaN(val) {
+    return 0
+  }
+  val = math.Trunc(val)
+  if val < float64(minVal) {
+    return -1
+  }
+  if val >= float64(maxVal)+1 { // float64(math.MaxInt64)+1 and float64(math.MaxUint64)+1 round to the next power of two
+    return 1
+  }
+  return 0
+}
+
+// integerDecimalValue - the converter cores do not handle decimals, converts them to int64 or uint64 (truncated toward zero)
+// values outside of both ranges become infinite float64 values, float64(-2^63 - 1) would round back into the int64 range
+// isDecimal is true for decimal sources, their range must always be checked since the float64 values wrap in the cores
+func integerDecimalValue(src any) (dst any, isDecimal bool) {
+  val, ok := src.(decimal.Decimal)
+  if !ok {
+    return src, false
+  }
+  intVal := val.Truncate(0).BigInt()
+  if intVal.IsInt64() {
+    return intVal.Int64(), true
+  }
+  if intVal.IsUint64() {
+    return intVal.Uint64(), true
+  }
+  return math.Inf(intVal.Sign()), true
+}
+
+// roundNumber - rounds the fractional sources of the integer converters using the rounding mode
+// the other sources and RoundTruncate (the default behavior of the converter cores) return src unchanged
+func roundNumber(src any, mode RoundingMode) any {
+  if mode == RoundTruncate {
+    return src
+  }
+  switch val := src.(type) {
+  case float32:
+    return float32(roundFloat(float64(val), mode))
+  case float64:
+    return roundFloat(val, mode)
+  case complex64:
+    return complex(float32(roundFloat(float64(real(val)), mode)), imag(val))
+  case complex128:
+    return complex(roundFloat(real(val), mode), imag(val))
+  case decimal.Decimal:
+    return roundDecimal(val, 0, mode)
+  case []byte:
+    if res := roundNumber(string(val), mode); res != string(val) {
+      return res
+    }
+  case string:
+    if _, er := strconv.ParseInt(val, 10, 64); er == nil {
+      return src
+    }
+    if _, er := strconv.ParseUint(val, 10, 64); er == nil {
+      return src
+    }
+    if floatVal, er := strconv.ParseFloat(val, 64); er == nil && hasFraction(floatVal) {
+      return roundFloat(floatVal, mode)
+    }
+  }
+  return src
+}
+
+// roundFloat - rounds the float to an integer using the rounding mode
+func roundFloat(val float64, mode RoundingMode) float64 {
+  switch mode {
+  case RoundHalfEven:
+    return math.RoundToEven(val)
+  case RoundHalfUp:
+    return math.Round(val)
+  case RoundFloor:
+    return math.Floor(val)
+  case RoundCeil:
+    return math.Ceil(val)
+  }
+  return math.Trunc(val)
+}
+
+// roundDecimal - rounds the decimal to places decimal places using the rounding mode
+func roundDecimal(val decimal.Decimal, places int32, mode RoundingMode) decimal.Decimal {
+  switch mode {
+  case RoundHalfEven:
+    return val.RoundBank(places)
+  case RoundHalfUp:
+    return val.Round(places)
+  case RoundFloor:
+    return val.RoundFloor(places)
+  case RoundCeil:
+    return val.RoundCeil(places)
+  }
+  return val.Truncate(places)
+}
+
+// floatDecimal - converts the finite float to a decimal, the shortest representation for the bitSize or the exact binary value with ConvertOptions.DecimalExactFloat
+func floatDecimal(val float64, bitSize int, opt ConvertOptions) (dst decimal.Decimal, err zerror.Error) {
+  if opt.DecimalExactFloat { // binary floats always have a terminating decimal expansion
+    dst, _ = ratDecimal(new(big.Rat).SetFloat64(val))
+    return dst, nil
+  }
+  if bitSize == 32 {
+    return decimal.NewFromFloat32(float32(val)), nil
+  }
+  return decimal.NewFromFloat(val), nil
+}
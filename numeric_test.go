@@ -0,0 +1,182 @@
+package zgen
+
+import (
+  "math"
+  "testing"
+
+  "github.com/shopspring/decimal"
+  "github.com/stretchr/testify/assert"
+)
+
+func TestUnit_OverflowSaturate(t *testing.T) {
+  saturate := ConvertOptions{Overflow: OverflowSaturate}
+
+  tests := []struct {
+    name    string
+    convert func() (any, error)
+    expect  any
+  }{
+    {name: "int8 above max", convert: func() (any, error) { return Int8(300, saturate) }, expect: int8(math.MaxInt8)},
+    {name: "int8 below min", convert: func() (any, error) { return Int8(-300, saturate) }, expect: int8(math.MinInt8)},
+    {name: "int8 in range", convert: func() (any, error) { return Int8(-12, saturate) }, expect: int8(-12)},
+    {name: "uint8 negative", convert: func() (any, error) { return Uint8(-5, saturate) }, expect: uint8(0)},
+    {name: "uint16 from float", convert: func() (any, error) { return Uint16(1e9, saturate) }, expect: uint16(math.MaxUint16)},
+    {name: "int32 from string", convert: func() (any, error) { return Int32("-99999999999", saturate) }, expect: int32(math.MinInt32)},
+    {name: "int64 from uint64", convert: func() (any, error) { return Int64(uint64(math.MaxUint64), saturate) }, expect: int64(math.MaxInt64)},
+    {name: "int64 from float", convert: func() (any, error) { return Int64(-1e30, saturate) }, expect: int64(math.MinInt64)},
+    {name: "uint64 from negative", convert: func() (any, error) { return Uint64(-1, saturate) }, expect: uint64(0)},
+    {name: "uint32 from +Inf", convert: func() (any, error) { return Uint32(math.Inf(1), saturate) }, expect: uint32(math.MaxUint32)},
+    {name: "int16 from decimal", convert: func() (any, error) { return Int16(decimal.RequireFromString("123456.7"), saturate) }, expect: int16(math.MaxInt16)},
+    {name: "int from uint64", convert: func() (any, error) { return Int(uint64(math.MaxUint64), saturate) }, expect: math.MaxInt},
+  }
+
+  for _, tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      res, err := tt.convert()
+      assert.Nil(t, err)
+      assert.Equal(t, tt.expect, res)
+    })
+  }
+
+  t.Run("default policy returns the overflow error", func(t *testing.T) {
+    _, err := Int8(300)
+    if assert.NotNil(t, err) {
+      assert.True(t, err.Has(ErrorConvertorNumberOverflow))
+    }
+  })
+
+  t.Run("decimal sources are always range checked", func(t *testing.T) {
+    sources := []func() (any, error){
+      func() (any, error) { return Int64(decimal.RequireFromString("1e30")) },
+      func() (any, error) { return Int64(decimal.RequireFromString("-1e30")) },
+      func() (any, error) { return Int64(decimal.RequireFromString("9223372036854775808")) },
+      func() (any, error) { return Uint64(decimal.RequireFromString("1e30")) },
+      func() (any, error) { return Uint64(decimal.RequireFromString("18446744073709551616")) },
+      func() (any, error) { return Uint64(decimal.RequireFromString("-1")) },
+    }
+    for _, convert := range sources {
+      _, err := convert()
+      if assert.NotNil(t, err) {
+        assert.True(t, err.(interface{ Has(string) bool }).Has(ErrorConvertorNumberOverflow), err.Error())
+      }
+    }
+
+    res, err := Uint64(decimal.RequireFromString("18446744073709551615"))
+    assert.Nil(t, err)
+    assert.Equal(t, uint64(math.MaxUint64), res)
+  })
+}
+
+func TestUnit_RoundingMode(t *testing.T) {
+  tests := []struct {
+    name   string
+    input  any
+    mode   RoundingMode
+    expect int
+  }{
+    {name: "truncate positive", input: 2.7, mode: RoundTruncate, expect: 2},
+    {name: "truncate negative", input: -2.7, mode: RoundTruncate, expect: -2},
+    {name: "half even tie down", input: 2.5, mode: RoundHalfEven, expect: 2},
+    {name: "half even tie up", input: 3.5, mode: RoundHalfEven, expect: 4},
+    {name: "half up tie", input: 2.5, mode: RoundHalfUp, expect: 3},
+    {name: "half up negative tie", input: -2.5, mode: RoundHalfUp, expect: -3},
+    {name: "floor", input: -2.1, mode: RoundFloor, expect: -3},
+    {name: "ceil", input: 2.1, mode: RoundCeil, expect: 3},
+    {name: "float32", input: float32(1.5), mode: RoundHalfUp, expect: 2},
+    {name: "string", input: "2.5", mode: RoundHalfEven, expect: 2},
+    {name: "[]byte", input: []byte("-2.5"), mode: RoundCeil, expect: -2},
+    {name: "integer string unchanged", input: "42", mode: RoundFloor, expect: 42},
+    {name: "decimal half even", input: decimal.RequireFromString("4.5"), mode: RoundHalfEven, expect: 4},
+    {name: "decimal half up", input: decimal.RequireFromString("4.5"), mode: RoundHalfUp, expect: 5},
+    {name: "decimal floor", input: decimal.RequireFromString("-4.1"), mode: RoundFloor, expect: -5},
+    {name: "decimal ceil", input: decimal.RequireFromString("4.1"), mode: RoundCeil, expect: 5},
+    {name: "complex", input: complex(1.6, 0), mode: RoundHalfUp, expect: 2},
+  }
+
+  for _, tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      res, err := Int(tt.input, ConvertOptions{Rounding: tt.mode})
+      assert.Nil(t, err)
+      assert.Equal(t, tt.expect, res)
+    })
+  }
+
+  t.Run("rounding before strict validation", func(t *testing.T) {
+    res, err := Uint8(2.5, ConvertOptions{Rounding: RoundCeil, Strict: true})
+    assert.Nil(t, err)
+    assert.Equal(t, uint8(3), res)
+  })
+
+  t.Run("rounding and saturation", func(t *testing.T) {
+    res, err := Int8(127.5, ConvertOptions{Rounding: RoundHalfUp, Overflow: OverflowSaturate})
+    assert.Nil(t, err)
+    assert.Equal(t, int8(127), res)
+  })
+}
+
+func TestUnit_NumericPoliciesToStruct(t *testing.T) {
+  dst := struct {
+    Level  int8   `json:"level"`
+    Signal uint16 `json:"signal"`
+  }{}
+
+  config := DefaultParserConfig
+  config.Overflow = OverflowSaturate
+  config.Rounding = RoundHalfUp
+  err := ToStruct(&dst, config, map[string]any{"level": 1000, "signal": "12.5"})
+  assert.Nil(t, err)
+  assert.Equal(t, int8(math.MaxInt8), dst.Level)
+  assert.Equal(t, uint16(13), dst.Signal)
+}
+
+func TestUnit_IntegerLiterals(t *testing.T) {
+  tests := []struct {
+    name      string
+    convert   func() (any, error)
+    expect    any
+    errorCode string
+  }{
+    {name: "hex", convert: func() (any, error) { return Int("0x1F") }, expect: 31},
+    {name: "upper hex", convert: func() (any, error) { return Uint8("0XFF") }, expect: uint8(255)},
+    {name: "negative hex", convert: func() (any, error) { return Int16("-0x10") }, expect: int16(-16)},
+    {name: "octal", convert: func() (any, error) { return Uint32("0o755") }, expect: uint32(493)},
+    {name: "binary", convert: func() (any, error) { return Int8([]byte("0b1010")) }, expect: int8(10)},
+    {name: "underscores", convert: func() (any, error) { return Int32("1_000_000") }, expect: int32(1000000)},
+    {name: "hex with underscores", convert: func() (any, error) { return Uint16("0xFF_FF") }, expect: uint16(math.MaxUint16)},
+    {name: "leading zeros stay decimal", convert: func() (any, error) { return Int("0755") }, expect: 755},
+    {name: "int64 above 2^53", convert: func() (any, error) { return Int64("9007199254740993") }, expect: int64(9007199254740993)},
+    {name: "int above 2^53", convert: func() (any, error) { return Int("-9007199254740993") }, expect: -9007199254740993},
+    {name: "uint64 max", convert: func() (any, error) { return Uint64("18446744073709551615") }, expect: uint64(math.MaxUint64)},
+    {name: "uint64 hex max", convert: func() (any, error) { return Uint64("0xFFFFFFFFFFFFFFFF") }, expect: uint64(math.MaxUint64)},
+    {name: "float string still supported", convert: func() (any, error) { return Int("12.7") }, expect: 12},
+    {name: "int8 hex overflow", convert: func() (any, error) { return Int8("0x80") }, errorCode: ErrorConvertorNumberOverflow},
+    {name: "int64 overflow", convert: func() (any, error) { return Int64("9223372036854775808") }, errorCode: ErrorConvertorNumberOverflow},
+    {name: "int64 negative overflow", convert: func() (any, error) { return Int64("-9223372036854775809") }, errorCode: ErrorConvertorNumberOverflow},
+    {name: "int negative hex overflow", convert: func() (any, error) { return Int("-0x8000_0000_0000_0001") }, errorCode: ErrorConvertorNumberOverflow},
+    {name: "strict int64 negative overflow", convert: func() (any, error) { return Int64("-9223372036854775809", ConvertOptions{Strict: true}) }, errorCode: ErrorConvertorNumberOverflow},
+    {name: "uint64 hex above max", convert: func() (any, error) { return Uint64("0x1_0000_0000_0000_0000") }, errorCode: ErrorConvertorNumberOverflow},
+    {name: "int64 hex above max", convert: func() (any, error) { return Int64("0x1_0000_0000_0000_0000") }, errorCode: ErrorConvertorNumberOverflow},
+    {name: "duration negative overflow", convert: func() (any, error) { return Duration("-9223372036854775809") }, errorCode: ErrorConvertorNumberOverflow},
+    {name: "saturate negative literal", convert: func() (any, error) { return Int64("-99999999999999999999", ConvertOptions{Overflow: OverflowSaturate}) }, expect: int64(math.MinInt64)},
+    {name: "uint negative", convert: func() (any, error) { return Uint("-0b1") }, errorCode: ErrorConvertorNumberOverflow},
+    {name: "misplaced underscore", convert: func() (any, error) { return Int("1__000") }, errorCode: ErrorConvertorTypeNotSupported},
+    {name: "invalid hex digit", convert: func() (any, error) { return Int("0x1G") }, errorCode: ErrorConvertorTypeNotSupported},
+    {name: "strict hex", convert: func() (any, error) { return Int("0x1F", ConvertOptions{Strict: true}) }, expect: 31},
+    {name: "strict large int64", convert: func() (any, error) { return Int64("9007199254740993", ConvertOptions{Strict: true}) }, expect: int64(9007199254740993)},
+    {name: "saturate hex", convert: func() (any, error) { return Uint8("0x1FF", ConvertOptions{Overflow: OverflowSaturate}) }, expect: uint8(math.MaxUint8)},
+  }
+
+  for _, tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      res, err := tt.convert()
+      if tt.errorCode != "" {
+        if assert.NotNil(t, err) {
+          assert.True(t, err.(interface{ Has(string) bool }).Has(tt.errorCode), "Expected error code '%s' but got '%s'", tt.errorCode, err.Error())
+        }
+        return
+      }
+      assert.Nil(t, err)
+      assert.Equal(t, tt.expect, res)
+    })
+  }
+}
@@ -0,0 +1,113 @@
+package zgen
+
+import (
+  "time"
+)
+
+// ConvertOptions - conversion settings accepted by the converters (as an optional last parameter) and embedded in ParserConfig
+// the zero value keeps the default converter behavior
+type ConvertOptions struct {
+  Converters *ConverterRegistry `json:"-"`          // scoped converters, consulted before the DefaultConverterRegistry
+  Strict     bool               `json:"strict"`     // rejects conversions that lose information or inputs that do not parse
+  Overflow   OverflowPolicy     `json:"overflow"`   // integer converters: error (default) or saturate to the destination min/max when the value is out of range
+  Rounding   RoundingMode       `json:"rounding"`   // integer converters and DecimalScale: how floats, decimals and numeric strings are rounded (default truncate toward zero)
+  NonFinite  NonFinitePolicy    `json:"non_finite"` // Float32, Float64, integer converters, Decimal, String and Bool: how NaN and infinite values are handled
+
+  NumberFormat *NumberFormat  `json:"number_format"` // numeric converters: locale format of the numeric strings ("1.234,56"), other strings are rejected, nil accepts only the canonical format
+  DurationUnit time.Duration `json:"duration_unit"` // Duration: unit of the numeric sources (time.Second, time.Millisecond, etc.), nanoseconds if 0
+
+  DecimalScale      *int32 `json:"decimal_scale"`       // Decimal, StringWithOptions: number of decimal places of the decimals (rounded with Rounding), the scale is kept if nil
+  DecimalExactFloat bool   `json:"decimal_exact_float"` // Decimal: floats are converted to their exact binary value (0.1 is 0.1000000000000000055511151231257827021181583404541015625) instead of the shortest representation
+
+  ComplexPolar bool `json:"complex_polar"` // Complex64, Complex128: the pairs ([2]float64, []any) are [magnitude, phase in radians] instead of [real, imag]
+
+  TimeLayout       string              `json:"time_layout"`       // Time: the only layout accepted for strings, disables TimeLayouts and dateparse
+  TimeLayoutStyle  LayoutStyle         `json:"time_layout_style"` // Time, FormatTime: syntax of TimeLayout (Go, strftime, Java or moment), the TimeLayouts are always Go layouts
+  TimeLayouts      *TimeLayoutRegistry `json:"-"`                 // Time: layouts tried in order, DefaultTimeLayoutRegistry if nil
+  DisableDateparse bool                `json:"disable_dateparse"` // Time: strings that do not match the layouts are not sent to dateparse
+
+  Location          *time.Location `json:"-"`          // Time: location of the inputs without a zone (strings, unix times, date parts), strings use UTC and unix times use time.Local if nil
+  NormalizeLocation *time.Location `json:"-"`          // Time: all the results are converted to this location (same instant), results keep their location if nil
+  EpochUnit         EpochUnit      `json:"epoch_unit"` // Time: unit of the numeric unix times, seconds if not set, numeric strings are unix times only if it is set
+
+  RelativeTime bool             `json:"relative_time"` // Time: accepts relative expressions ("now-15m", "-7d", "today", "now/d", "start of month")
+  Clock        func() time.Time `json:"-"`             // Time: current time used by the relative expressions, time.Now if nil
+
+  Separator   string `json:"separator"`    // slice converters: strings are split on the separator (CSV style quoting), strings are single elements if empty
+  JSONStrings bool   `json:"json_strings"` // slice and map converters: strings starting with "[" or "{" are decoded as JSON (numbers as json.Number)
+
+  BoolWords *BoolWords `json:"bool_words"` // Bool: truthy and falsy words of the string sources, DefaultBoolWords (and numeric strings) if nil, StringWithOptions writes the first word of each list
+
+  FloatFormat    byte          `json:"float_format"`    // StringWithOptions: strconv format of the floats ('f', 'g', 'e'), 'g' for float32 and 'f' for float64 if 0
+  FloatPrecision *int          `json:"float_precision"` // StringWithOptions: digits of the floats (decimals for 'f' and 'e'), the shortest representation if nil
+  DurationStyle  DurationStyle `json:"duration_style"`  // StringWithOptions: Go (default, "1h30m0s"), ISO-8601 ("PT1H30M") or a number of DurationUnit
+  NilString      string        `json:"nil_string"`      // StringWithOptions: text of nil values and nil pointers, empty if not set
+}
+
+// OverflowPolicy - what the integer converters do with values outside the destination range
+type OverflowPolicy int
+
+const (
+  OverflowError    OverflowPolicy = iota // returns ErrorConvertorNumberOverflow
+  OverflowSaturate                       // clamps the value to the destination min/max
+)
+
+// RoundingMode - how the integer converters round fractional values
+type RoundingMode int
+
+const (
+  RoundTruncate RoundingMode = iota // rounds toward zero
+  RoundHalfEven                     // rounds to the nearest integer, ties to even (banker's rounding)
+  RoundHalfUp                       // rounds to the nearest integer, ties away from zero
+  RoundFloor                        // rounds toward negative infinity
+  RoundCeil                         // rounds toward positive infinity
+)
+
+// NonFinitePolicy - how the converters handle NaN and infinite sources (floats, complex numbers, infinite *big.Float and strings like "NaN", "-Inf")
+type NonFinitePolicy int
+
+const (
+  NonFiniteDefault NonFinitePolicy = iota // each converter keeps its behavior: Float64 keeps float64 sources, String writes them, the other destinations return ErrorConvertorNumberOverflow
+  NonFiniteAllow                          // Float32 and Float64 keep them from every source, Bool is true, String writes them, integers and decimals return ErrorConvertorNumberOverflow
+  NonFiniteReject                         // returns ErrorConvertorNonFinite
+  NonFiniteZero                           // converted like nil: 0, false or ConvertOptions.NilString
+)
+
+// DurationStyle - how StringWithOptions writes durations
+type DurationStyle int
+
+const (
+  DurationGo     DurationStyle = iota // time.Duration.String() ("1h30m0s")
+  DurationISO                         // ISO-8601 with day, hour, minute and second designators ("P1DT2H", "PT1.5S", "PT0S")
+  DurationNumber                      // number of ConvertOptions.DurationUnit (nanoseconds if not set), "1.5" for 1500ms in seconds
+)
+
+var (
+  // DefaultConvertOptions - options used by the converters when none are sent
+  DefaultConvertOptions = ConvertOptions{}
+)
+
+// getConvertOptions - returns the options sent to a converter or the DefaultConvertOptions if none were sent
+func getConvertOptions(opts []ConvertOptions) ConvertOptions {
+  if len(opts) > 0 {
+    return opts[0]
+  }
+  return DefaultConvertOptions
+}
+
+// EpochUnit - unit of the numeric unix times converted by Time
+type EpochUnit int
+
+const (
+  EpochDefault EpochUnit = iota // numbers are seconds, numeric strings are parsed as dates
+  EpochSeconds                  // numbers and numeric strings are seconds
+  EpochMillis                   // numbers and numeric strings are milliseconds (JavaScript timestamps)
+  EpochMicros                   // numbers and numeric strings are microseconds
+  EpochNanos                    // numbers and numeric strings are nanoseconds
+  EpochAuto                     // the unit is detected by magnitude: seconds below 1e11, millis below 1e14, micros below 1e17, nanos above
+
+  EpochExcel1900         // spreadsheet serial date in the 1900 date system (Excel on Windows, LibreOffice), days since 1899-12-30, fractions are the time of day
+  EpochExcel1904         // spreadsheet serial date in the 1904 date system (old Excel on Mac), days since 1904-01-01
+  EpochJulianDay         // Julian day number, days since noon UTC of -4713-11-24 (proleptic Gregorian calendar)
+  EpochModifiedJulianDay // Modified Julian day number, days since midnight UTC of 1858-11-17
+)
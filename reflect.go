@@ -3,8 +3,15 @@ package zgen
 import (
   "database/sql/driver"
   "github.com/znxlc/zerror"
+  "math/big"
+  "net"
+  "net/netip"
+  "net/url"
   "reflect"
   "time"
+
+  "github.com/gofrs/uuid"
+  "github.com/shopspring/decimal"
 )
 
 // SetFieldValueByType sets a field value based on the field type with automatic type conversion.
@@ -12,6 +19,7 @@ import (
 // This function handles setting values of various types with appropriate type conversion.
 // It supports all basic Go types, pointers, interfaces, and custom types that implement
 // the Scanner or driver.Valuer interfaces. For time.Time, it uses the Time() conversion function.
+// Converters registered in currentParseSettings.Converters or the DefaultConverterRegistry take priority over the built in conversions.
 //
 // The function makes the following assumptions:
 //   - The destination field is valid and settable
@@ -32,7 +40,11 @@ import (
 //   - Pointers to any supported type
 //   - Interfaces (empty interface{} and interface with methods)
 //   - time.Time and *time.Time
+//   - big.Int, big.Float and big.Rat (and pointers to them)
+//   - uuid.UUID and NullUUID
+//   - net.IP, netip.Addr, netip.Prefix, net.HardwareAddr and *url.URL
 //   - Types implementing Scanner or driver.Valuer interfaces
+//   - Types with a registered converter (see RegisterConverter)
 //   - Slices, maps, and channels (with some limitations)
 //
 // Examples:
@@ -86,10 +98,20 @@ func SetFieldValueByType(currentParseSettings ParserConfig, dstFieldReflectValue
       }
     }
 
-    if dstFieldReflectValue.Type() == srcReflectValue.Type() { // if they are the same type we set it directly
+    if dstFieldReflectValue.Type() == srcReflectValue.Type() && !(currentParseSettings.DecimalScale != nil && srcReflectValue.Type() == reflect.TypeOf(decimal.Decimal{})) { // if they are the same type we set it directly, decimals are rounded to the scale if set
       dstFieldReflectValue.Set(srcReflectValue)
       return nil
     }
+    if dstFieldReflectValue.Kind() != reflect.Interface { // registered converters take priority over the built in conversions
+      converted, found, err := lookupRegistered(srcValue, dstFieldReflectValue.Type(), currentParseSettings.Converters)
+      if found {
+        if err != nil {
+          return err
+        }
+        dstFieldReflectValue.Set(reflect.ValueOf(converted))
+        return nil
+      }
+    }
     // different types so we start converting
     switch dstFieldReflectValue.Kind() {
     case reflect.Ptr: // recurse inside the element
@@ -111,6 +133,95 @@ func SetFieldValueByType(currentParseSettings ParserConfig, dstFieldReflectValue
         dstFieldReflectValue.Set(srcReflectValue)
       }
     case reflect.Struct: // we have a struct field
+      switch dstField := dstFieldReflectValue.Addr().Interface().(type) { // big numbers, addresses and urls use the converters so the conversion options are applied
+      case *big.Int:
+        bigSrcValue, err := BigInt(srcValue, currentParseSettings.ConvertOptions)
+        if err != nil {
+          return err
+        }
+        dstField.Set(bigSrcValue)
+        return nil
+      case *big.Float:
+        bigSrcValue, err := BigFloat(srcValue, currentParseSettings.ConvertOptions)
+        if err != nil {
+          return err
+        }
+        dstField.Set(bigSrcValue)
+        return nil
+      case *big.Rat:
+        bigSrcValue, err := BigRat(srcValue, currentParseSettings.ConvertOptions)
+        if err != nil {
+          return err
+        }
+        dstField.Set(bigSrcValue)
+        return nil
+      case *netip.Addr:
+        addrSrcValue, err := IPAddr(srcValue, currentParseSettings.ConvertOptions)
+        if err != nil {
+          return err
+        }
+        *dstField = addrSrcValue
+        return nil
+      case *netip.Prefix:
+        prefixSrcValue, err := IPPrefix(srcValue, currentParseSettings.ConvertOptions)
+        if err != nil {
+          return err
+        }
+        *dstField = prefixSrcValue
+        return nil
+      case *url.URL:
+        urlSrcValue, err := URL(srcValue, currentParseSettings.ConvertOptions)
+        if err != nil {
+          return err
+        }
+        if urlSrcValue != nil {
+          *dstField = *urlSrcValue
+        }
+        return nil
+      }
+      if _, ok := dstFieldReflectValue.Interface().(decimal.Decimal); ok { // decimals use the converter so the conversion options are applied
+        decimalSrcValue, err := Decimal(srcValue, currentParseSettings.ConvertOptions)
+        if err == nil {
+          dstFieldReflectValue.Set(reflect.ValueOf(decimalSrcValue))
+          return nil
+        }
+        if !err.Has(ErrorConvertorTypeNotSupported) { // unsupported sources are sent to the decimal Scanner below
+          return err
+        }
+      }
+      if _, ok := dstFieldReflectValue.Interface().(NullTime); ok && srcReflectValue.Kind() == reflect.String { // time strings use the parser time options
+        timeSrcValue, err := Time(srcValue, currentParseSettings.ConvertOptions)
+        if err != nil {
+          return err
+        }
+        nullTime := NullTime{}
+        nullTime.Time, nullTime.Valid = timeSrcValue, true
+        dstFieldReflectValue.Set(reflect.ValueOf(nullTime))
+        return nil
+      }
+      if _, ok := dstFieldReflectValue.Interface().(NullBool); ok && (srcReflectValue.Kind() == reflect.String || srcReflectValue.Type() == reflect.TypeOf([]byte{})) { // bool words use the parser vocabulary
+        boolSrcValue, err := Bool(srcValue, currentParseSettings.ConvertOptions)
+        if err != nil {
+          return err
+        }
+        nullBool := NullBool{}
+        nullBool.Bool, nullBool.Valid = boolSrcValue, true
+        dstFieldReflectValue.Set(reflect.ValueOf(nullBool))
+        return nil
+      }
+      if _, ok := dstFieldReflectValue.Interface().(NullUUID); ok { // null uuids use the converter, uuid.NullUUID sources keep their validity
+        uuidSrcValue, err := UUID(srcValue, currentParseSettings.ConvertOptions)
+        if err != nil {
+          return err
+        }
+        nullUUID := NullUUID{}
+        nullUUID.UUID, nullUUID.Valid = uuidSrcValue, true
+        if nullSrcValue, ok := srcValue.(uuid.NullUUID); ok {
+          nullUUID.Valid = nullSrcValue.Valid
+        }
+        dstFieldReflectValue.Set(reflect.ValueOf(nullUUID))
+        return nil
+      }
       if dstFieldScanner, ok := dstFieldReflectValue.Addr().Interface().(Scanner); ok { // we got a scanner
         if srcReflectValue.Kind() == reflect.Struct {
           // testing Valuer variants, unsuccessful scan(err != nil) will be ignored and we try next method
@@ -143,13 +254,13 @@ func SetFieldValueByType(currentParseSettings ParserConfig, dstFieldReflectValue
           return zerror.New(ErrorZGENScannerFailed, er)
         }
       } else if _, ok := dstFieldReflectValue.Interface().(time.Time); ok { // we got a time.Time element
-        timeSrcValue, err := Time(srcValue)
+        timeSrcValue, err := Time(srcValue, currentParseSettings.ConvertOptions)
         if err != nil {
           return err
         }
         dstFieldReflectValue.Set(reflect.ValueOf(timeSrcValue))
       } else if _, ok := dstFieldReflectValue.Interface().(*time.Time); ok { // we got a *time.Time element
-        timeSrcValue, er := Time(srcValue)
+        timeSrcValue, er := Time(srcValue, currentParseSettings.ConvertOptions)
         if er != nil {
           return er
         }
@@ -163,34 +274,62 @@ func SetFieldValueByType(currentParseSettings ParserConfig, dstFieldReflectValue
         }
         dstFieldReflectValue.Set(fv.Elem()) // passing the srcValue of the pointer
       }
-    case reflect.Map: // field is a map, srcValue must be a map (due to the ToMap any other struct should become a map, else the scan will return an error)
-      switch srcReflectValue.Kind() {
-      case reflect.Map:
-        if dstFieldReflectValue.IsNil() { // create a new map if nil, else we will not be able to assign values to it
-          newMap := reflect.MakeMap(dstFieldReflectValue.Type())
-          dstFieldReflectValue.Set(newMap)
+    case reflect.Map: // field is a map, srcValue must be a map or a slice of key/value pairs (due to the ToMap any other struct should become a map, else the scan will return an error)
+      dstType := dstFieldReflectValue.Type().String()
+      decodedSrcValue, err := decodeString(srcValue, dstType, currentParseSettings.ConvertOptions) // json strings if enabled
+      if err != nil {
+        return err
+      }
+      keys, values, err := mapEntries(decodedSrcValue, dstType)
+      if err != nil {
+        return err
+      }
+      if dstFieldReflectValue.IsNil() { // create a new map if nil, else we will not be able to assign values to it
+        newMap := reflect.MakeMap(dstFieldReflectValue.Type())
+        dstFieldReflectValue.Set(newMap)
+      }
+      convertedKeys := map[any]bool{} // source keys converted to the same key return an error
+      for idx := range keys {
+        fvKey := reflect.New(dstFieldReflectValue.Type().Key())
+        fvVal := reflect.New(dstFieldReflectValue.Type().Elem())
+        err = SetFieldValueByType(currentParseSettings, fvKey.Elem(), keys[idx])
+        if err != nil {
+          return mapEntryError(keys[idx], dstType, err)
         }
-        for _, mapKey := range srcReflectValue.MapKeys() {
-          fvKey := reflect.New(dstFieldReflectValue.Type().Key())
-          fvVal := reflect.New(dstFieldReflectValue.Type().Elem())
-          err = SetFieldValueByType(currentParseSettings, fvKey.Elem(), mapKey.Interface())
-          if err != nil {
-            return err
-          }
-          err = SetFieldValueByType(currentParseSettings, fvVal.Elem(), srcReflectValue.MapIndex(mapKey).Interface())
-          if err != nil {
-            return err
-          }
-          dstFieldReflectValue.SetMapIndex(fvKey.Elem(), fvVal.Elem())
+        if convertedKeys[fvKey.Elem().Interface()] {
+          return duplicateKeyError(keys[idx], dstType)
         }
-      default: // no other types are supported to cast to a map
-        err = zerror.New(ErrorConvertorTypeNotSupported, map[string]any{
-          "src_type": srcReflectValue.Type().String(),
-          "dst_type": dstFieldReflectValue.Type().String(),
-        })
-        return err
+        convertedKeys[fvKey.Elem().Interface()] = true
+        err = SetFieldValueByType(currentParseSettings, fvVal.Elem(), values[idx])
+        if err != nil {
+          return mapEntryError(keys[idx], dstType, err)
+        }
+        dstFieldReflectValue.SetMapIndex(fvKey.Elem(), fvVal.Elem())
       }
     case reflect.Slice:
+      switch dstFieldReflectValue.Type() { // addresses are parsed from their string forms instead of being filled byte by byte
+      case reflect.TypeOf(net.IP{}):
+        ipSrcValue, err := IP(srcValue, currentParseSettings.ConvertOptions)
+        if err != nil {
+          return err
+        }
+        dstFieldReflectValue.Set(reflect.ValueOf(ipSrcValue))
+        return nil
+      case reflect.TypeOf(net.HardwareAddr{}):
+        macSrcValue, err := HardwareAddr(srcValue, currentParseSettings.ConvertOptions)
+        if err != nil {
+          return err
+        }
+        dstFieldReflectValue.Set(reflect.ValueOf(macSrcValue))
+        return nil
+      }
+      if srcReflectValue.Kind() == reflect.String { // delimited and json strings if enabled
+        decodedSrcValue, err := decodeString(srcValue, dstFieldReflectValue.Type().String(), currentParseSettings.ConvertOptions)
+        if err != nil {
+          return err
+        }
+        srcReflectValue = reflect.ValueOf(decodedSrcValue)
+      }
       if dstFieldReflectValue.Len() > 0 { // we got an existing slice and it needs to be replaced to make sure we do not keep the old data
         newSlice := reflect.New(dstFieldReflectValue.Type())
         dstFieldReflectValue.Set(newSlice.Elem())
@@ -217,6 +356,14 @@ func SetFieldValueByType(currentParseSettings ParserConfig, dstFieldReflectValue
         return err
       }
     case reflect.Array:
+      if dstFieldReflectValue.Type() == reflect.TypeOf(uuid.UUID{}) { // uuids are parsed from the string forms instead of being filled byte by byte
+        uuidSrcValue, err := UUID(srcValue, currentParseSettings.ConvertOptions)
+        if err != nil {
+          return err
+        }
+        dstFieldReflectValue.Set(reflect.ValueOf(uuidSrcValue))
+        return nil
+      }
       switch srcReflectValue.Kind() {
       case reflect.Slice, reflect.Array:
         maxLen := dstFieldReflectValue.Cap()
@@ -239,91 +386,113 @@ func SetFieldValueByType(currentParseSettings ParserConfig, dstFieldReflectValue
         return err
       }
     case reflect.Uint:
-      retVal, err := Uint(srcReflectValue.Interface())
+      retVal, err := Uint(srcReflectValue.Interface(), currentParseSettings.ConvertOptions)
       if err != nil {
         return err
       }
       dstFieldReflectValue.Set(reflect.ValueOf(retVal).Convert(dstFieldReflectValue.Type()))
     case reflect.Uint8:
-      retVal, err := Uint8(srcReflectValue.Interface())
+      retVal, err := Uint8(srcReflectValue.Interface(), currentParseSettings.ConvertOptions)
       if err != nil {
         return err
       }
       dstFieldReflectValue.Set(reflect.ValueOf(retVal).Convert(dstFieldReflectValue.Type()))
     case reflect.Uint16:
-      retVal, err := Uint16(srcReflectValue.Interface())
+      retVal, err := Uint16(srcReflectValue.Interface(), currentParseSettings.ConvertOptions)
       if err != nil {
         return err
       }
       dstFieldReflectValue.Set(reflect.ValueOf(retVal).Convert(dstFieldReflectValue.Type()))
     case reflect.Uint32:
-      retVal, err := Uint32(srcReflectValue.Interface())
+      retVal, err := Uint32(srcReflectValue.Interface(), currentParseSettings.ConvertOptions)
       if err != nil {
         return err
       }
       dstFieldReflectValue.Set(reflect.ValueOf(retVal).Convert(dstFieldReflectValue.Type()))
     case reflect.Uint64:
-      retVal, err := Uint64(srcReflectValue.Interface())
+      if dstFieldReflectValue.Type() == reflect.TypeOf(ByteSize(0)) { // byte sizes accept the human readable formats ("512KiB", "2GB")
+        retVal, err := ToByteSize(srcReflectValue.Interface(), currentParseSettings.ConvertOptions)
+        if err != nil {
+          return err
+        }
+        dstFieldReflectValue.Set(reflect.ValueOf(retVal))
+        return nil
+      }
+      retVal, err := Uint64(srcReflectValue.Interface(), currentParseSettings.ConvertOptions)
+      if err != nil {
+        return err
+      }
+      dstFieldReflectValue.Set(reflect.ValueOf(retVal).Convert(dstFieldReflectValue.Type()))
+    case reflect.Bool:
+      retVal, err := Bool(srcReflectValue.Interface(), currentParseSettings.ConvertOptions)
       if err != nil {
         return err
       }
       dstFieldReflectValue.Set(reflect.ValueOf(retVal).Convert(dstFieldReflectValue.Type()))
     case reflect.Int:
-      retVal, err := Int(srcReflectValue.Interface())
+      retVal, err := Int(srcReflectValue.Interface(), currentParseSettings.ConvertOptions)
       if err != nil {
         return err
       }
       dstFieldReflectValue.Set(reflect.ValueOf(retVal).Convert(dstFieldReflectValue.Type()))
     case reflect.Int8:
-      retVal, err := Int8(srcReflectValue.Interface())
+      retVal, err := Int8(srcReflectValue.Interface(), currentParseSettings.ConvertOptions)
       if err != nil {
         return err
       }
       dstFieldReflectValue.Set(reflect.ValueOf(retVal).Convert(dstFieldReflectValue.Type()))
     case reflect.Int16:
-      retVal, err := Int16(srcReflectValue.Interface())
+      retVal, err := Int16(srcReflectValue.Interface(), currentParseSettings.ConvertOptions)
       if err != nil {
         return err
       }
       dstFieldReflectValue.Set(reflect.ValueOf(retVal).Convert(dstFieldReflectValue.Type()))
     case reflect.Int32:
-      retVal, err := Int32(srcReflectValue.Interface())
+      retVal, err := Int32(srcReflectValue.Interface(), currentParseSettings.ConvertOptions)
       if err != nil {
         return err
       }
       dstFieldReflectValue.Set(reflect.ValueOf(retVal).Convert(dstFieldReflectValue.Type()))
     case reflect.Int64:
-      retVal, err := Int64(srcReflectValue.Interface())
+      if dstFieldReflectValue.Type() == reflect.TypeOf(time.Duration(0)) { // durations accept the Duration formats ("1h30m", "PT1H30M", numbers with unit)
+        retVal, err := Duration(srcReflectValue.Interface(), currentParseSettings.ConvertOptions)
+        if err != nil {
+          return err
+        }
+        dstFieldReflectValue.Set(reflect.ValueOf(retVal))
+        return nil
+      }
+      retVal, err := Int64(srcReflectValue.Interface(), currentParseSettings.ConvertOptions)
       if err != nil {
         return err
       }
       dstFieldReflectValue.Set(reflect.ValueOf(retVal).Convert(dstFieldReflectValue.Type()))
     case reflect.Float32:
-      retVal, err := Float32(srcReflectValue.Interface())
+      retVal, err := Float32(srcReflectValue.Interface(), currentParseSettings.ConvertOptions)
       if err != nil {
         return err
       }
       dstFieldReflectValue.Set(reflect.ValueOf(retVal).Convert(dstFieldReflectValue.Type()))
     case reflect.Float64:
-      retVal, err := Float64(srcReflectValue.Interface())
+      retVal, err := Float64(srcReflectValue.Interface(), currentParseSettings.ConvertOptions)
       if err != nil {
         return err
       }
       dstFieldReflectValue.Set(reflect.ValueOf(retVal).Convert(dstFieldReflectValue.Type()))
     case reflect.Complex64:
-      retVal, err := Complex64(srcReflectValue.Interface())
+      retVal, err := Complex64(srcReflectValue.Interface(), currentParseSettings.ConvertOptions)
       if err != nil {
         return err
       }
       dstFieldReflectValue.Set(reflect.ValueOf(retVal).Convert(dstFieldReflectValue.Type()))
     case reflect.Complex128:
-      retVal, err := Complex128(srcReflectValue.Interface())
+      retVal, err := Complex128(srcReflectValue.Interface(), currentParseSettings.ConvertOptions)
       if err != nil {
         return err
       }
       dstFieldReflectValue.Set(reflect.ValueOf(retVal).Convert(dstFieldReflectValue.Type()))
     case reflect.String:
-      retVal, err := String(srcReflectValue.Interface())
+      retVal, err := StringWithOptions(srcReflectValue.Interface(), currentParseSettings.ConvertOptions)
       if err != nil {
         return err
       }
@@ -0,0 +1,196 @@
+package zgen
+
+import (
+  "github.com/znxlc/zerror"
+  "reflect"
+  "sync"
+)
+
+// ConverterFunc - custom conversion function, receives the source value and returns a value of the registered destination type
+type ConverterFunc func(src any) (any, error)
+
+// converterEntry - a registered converter and the types it was registered for
+type converterEntry struct {
+  srcType reflect.Type
+  dstType reflect.Type
+  fn      ConverterFunc
+}
+
+// converterKey - exact type pair used to index registered converters
+type converterKey struct {
+  srcType reflect.Type
+  dstType reflect.Type
+}
+
+// ConverterRegistry - holds custom converters for third-party and domain types
+// Converters are matched by exact source and destination types first,
+// after that by assignable types (source assignable to the registered source type, registered destination assignable to the requested type)
+// in registration order
+type ConverterRegistry struct {
+  mu      sync.RWMutex
+  exact   map[converterKey]*converterEntry
+  entries []*converterEntry
+}
+
+// DefaultConverterRegistry - global registry consulted by all converters, ToStruct, ScanToElement and DeepMerge
+var DefaultConverterRegistry = NewConverterRegistry()
+
+// NewConverterRegistry - creates an empty converter registry
+// use it with ParserConfig.Converters to scope registrations to a parser instance
+func NewConverterRegistry() *ConverterRegistry {
+  return &ConverterRegistry{
+    exact: map[converterKey]*converterEntry{},
+  }
+}
+
+// RegisterConverter - registers a converter from srcType to dstType in the DefaultConverterRegistry
+// example:
+//
+//	RegisterConverter(reflect.TypeOf(""), reflect.TypeOf(uuid.UUID{}), func(src any) (any, error) {
+//	  return uuid.FromString(src.(string))
+//	})
+func RegisterConverter(srcType, dstType reflect.Type, fn ConverterFunc) {
+  DefaultConverterRegistry.Register(srcType, dstType, fn)
+}
+
+// UnregisterConverter - removes the srcType to dstType converter from the DefaultConverterRegistry
+func UnregisterConverter(srcType, dstType reflect.Type) {
+  DefaultConverterRegistry.Unregister(srcType, dstType)
+}
+
+// Register - registers a converter from srcType to dstType, an existing converter for the same types is replaced
+func (r *ConverterRegistry) Register(srcType, dstType reflect.Type, fn ConverterFunc) {
+  if srcType == nil || dstType == nil || fn == nil {
+    return
+  }
+  r.mu.Lock()
+  defer r.mu.Unlock()
+
+  key := converterKey{srcType: srcType, dstType: dstType}
+  if entry, ok := r.exact[key]; ok { // replace the function and keep the registration order
+    entry.fn = fn
+    return
+  }
+  entry := &converterEntry{srcType: srcType, dstType: dstType, fn: fn}
+  r.exact[key] = entry
+  r.entries = append(r.entries, entry)
+}
+
+// Unregister - removes the srcType to dstType converter
+func (r *ConverterRegistry) Unregister(srcType, dstType reflect.Type) {
+  r.mu.Lock()
+  defer r.mu.Unlock()
+
+  key := converterKey{srcType: srcType, dstType: dstType}
+  entry, ok := r.exact[key]
+  if !ok {
+    return
+  }
+  delete(r.exact, key)
+  for idx, existing := range r.entries {
+    if existing == entry {
+      r.entries = append(r.entries[:idx], r.entries[idx+1:]...)
+      break
+    }
+  }
+}
+
+// Lookup - returns the converter matching the source and destination types
+func (r *ConverterRegistry) Lookup(srcType, dstType reflect.Type) (fn ConverterFunc, found bool) {
+  if r == nil || srcType == nil || dstType == nil {
+    return nil, false
+  }
+  r.mu.RLock()
+  defer r.mu.RUnlock()
+
+  if len(r.entries) == 0 {
+    return nil, false
+  }
+  if entry, ok := r.exact[converterKey{srcType: srcType, dstType: dstType}]; ok {
+    return entry.fn, true
+  }
+  for _, entry := range r.entries {
+    if srcType.AssignableTo(entry.srcType) && entry.dstType.AssignableTo(dstType) {
+      return entry.fn, true
+    }
+  }
+
+  return nil, false
+}
+
+// Convert - converts src to dstType using a registered converter
+// found is false if no converter matches, err contains the converter error or a type mismatch of the converter result
+func (r *ConverterRegistry) Convert(src any, dstType reflect.Type) (dst any, found bool, err zerror.Error) {
+  if src == nil {
+    return nil, false, nil
+  }
+  fn, found := r.Lookup(reflect.TypeOf(src), dstType)
+  if !found {
+    return nil, false, nil
+  }
+
+  return callConverter(fn, src, dstType)
+}
+
+// callConverter - runs the converter and validates its result against the destination type
+// the result must be assignable to the destination or have the same underlying type (named types), else ErrorConvertorCustomFailed is returned
+func callConverter(fn ConverterFunc, src any, dstType reflect.Type) (dst any, found bool, err zerror.Error) {
+  result, er := fn(src)
+  if er != nil {
+    return nil, true, zerror.New(ErrorConvertorCustomFailed, map[string]any{
+      "src":      src,
+      "src_type": reflect.TypeOf(src).String(),
+      "dst_type": dstType.String(),
+      "error":    er.Error(),
+    })
+  }
+  if result == nil {
+    return reflect.Zero(dstType).Interface(), true, nil
+  }
+  resultValue := reflect.ValueOf(result)
+  if resultValue.Type().AssignableTo(dstType) {
+    return result, true, nil
+  }
+  if resultValue.Kind() == dstType.Kind() && resultValue.Type().ConvertibleTo(dstType) { // named types with the same underlying type, other conversions (int to string, float64 to int) would change the value
+    return resultValue.Convert(dstType).Interface(), true, nil
+  }
+
+  return nil, true, zerror.New(ErrorConvertorCustomFailed, map[string]any{
+    "src":         src,
+    "src_type":    reflect.TypeOf(src).String(),
+    "dst_type":    dstType.String(),
+    "result_type": resultValue.Type().String(),
+  })
+}
+
+// convertRegistered - converts src to the type T using the parser scoped registry (if any) and the DefaultConverterRegistry
+func convertRegistered[T any](src any, registries ...*ConverterRegistry) (dst T, found bool, err zerror.Error) {
+  if src == nil {
+    return dst, false, nil
+  }
+  dstType := reflect.TypeOf((*T)(nil)).Elem()
+  result, found, err := lookupRegistered(src, dstType, registries...)
+  if !found || err != nil {
+    return dst, found, err
+  }
+  if result != nil {
+    dst = result.(T)
+  }
+
+  return dst, true, nil
+}
+
+// lookupRegistered - checks the scoped registries first and the DefaultConverterRegistry last
+func lookupRegistered(src any, dstType reflect.Type, registries ...*ConverterRegistry) (dst any, found bool, err zerror.Error) {
+  for _, registry := range registries {
+    if registry == nil {
+      continue
+    }
+    dst, found, err = registry.Convert(src, dstType)
+    if found {
+      return dst, found, err
+    }
+  }
+
+  return DefaultConverterRegistry.Convert(src, dstType)
+}
@@ -0,0 +1,197 @@
+package zgen
+
+import (
+  "errors"
+  "reflect"
+  "strconv"
+  "strings"
+  "testing"
+
+  "github.com/stretchr/testify/assert"
+)
+
+type registryTestID struct {
+  prefix string
+  number int
+}
+
+func (id registryTestID) String() string {
+  return id.prefix + "-" + strconv.Itoa(id.number)
+}
+
+type registryTestPoint struct {
+  X int
+  Y int
+}
+
+func parseRegistryTestID(src any) (any, error) {
+  parts := strings.Split(src.(string), "-")
+  if len(parts) != 2 {
+    return nil, errors.New("invalid id")
+  }
+  number, err := Int(parts[1])
+  if err != nil {
+    return nil, err
+  }
+  return registryTestID{prefix: parts[0], number: number}, nil
+}
+
+func TestUnit_RegisterConverter(t *testing.T) {
+  stringType := reflect.TypeOf("")
+  idType := reflect.TypeOf(registryTestID{})
+  intType := reflect.TypeOf(0)
+
+  RegisterConverter(stringType, idType, parseRegistryTestID)
+  RegisterConverter(idType, intType, func(src any) (any, error) {
+    return src.(registryTestID).number, nil
+  })
+  t.Cleanup(func() {
+    UnregisterConverter(stringType, idType)
+    UnregisterConverter(idType, intType)
+  })
+
+  t.Run("built in converter", func(t *testing.T) {
+    res, err := Int(registryTestID{prefix: "usr", number: 7})
+    assert.Nil(t, err)
+    assert.Equal(t, 7, res)
+  })
+
+  t.Run("generic converter", func(t *testing.T) {
+    res, err := To[registryTestID]("usr-5")
+    assert.Nil(t, err)
+    assert.Equal(t, registryTestID{prefix: "usr", number: 5}, res)
+  })
+
+  t.Run("converter error", func(t *testing.T) {
+    _, err := To[registryTestID]("invalid")
+    if assert.NotNil(t, err) {
+      assert.True(t, err.Has(ErrorConvertorCustomFailed))
+    }
+  })
+
+  t.Run("ScanToElement", func(t *testing.T) {
+    var id *registryTestID
+    err := ScanToElement(&id, "grp-3")
+    assert.Nil(t, err)
+    if assert.NotNil(t, id) {
+      assert.Equal(t, registryTestID{prefix: "grp", number: 3}, *id)
+    }
+  })
+
+  t.Run("ToStruct", func(t *testing.T) {
+    dst := struct {
+      ID    registryTestID `json:"id"`
+      Count int            `json:"count"`
+    }{}
+    err := ToStruct(&dst, map[string]any{"id": "usr-2", "count": registryTestID{number: 4}})
+    assert.Nil(t, err)
+    assert.Equal(t, registryTestID{prefix: "usr", number: 2}, dst.ID)
+    assert.Equal(t, 4, dst.Count)
+  })
+
+  t.Run("unregistered", func(t *testing.T) {
+    UnregisterConverter(idType, intType)
+    _, err := Int(registryTestID{number: 7})
+    assert.NotNil(t, err)
+  })
+}
+
+func TestUnit_ConverterRegistryAssignable(t *testing.T) {
+  registry := NewConverterRegistry()
+  stringableType := reflect.TypeOf((*Stringable)(nil)).Elem()
+  registry.Register(stringableType, reflect.TypeOf(registryTestPoint{}), func(src any) (any, error) {
+    return registryTestPoint{X: len(src.(Stringable).String())}, nil
+  })
+
+  res, found, err := registry.Convert(registryTestID{prefix: "ab", number: 1}, reflect.TypeOf(registryTestPoint{}))
+  assert.True(t, found)
+  assert.Nil(t, err)
+  assert.Equal(t, registryTestPoint{X: 4}, res)
+
+  _, found, _ = registry.Convert(12, reflect.TypeOf(registryTestPoint{}))
+  assert.False(t, found)
+}
+
+func TestUnit_ConverterRegistryResultType(t *testing.T) {
+  type label string
+  registry := NewConverterRegistry()
+  pointType := reflect.TypeOf(registryTestPoint{})
+  registry.Register(pointType, reflect.TypeOf(""), func(src any) (any, error) {
+    return 65, nil // int results are not converted to one rune strings
+  })
+  registry.Register(pointType, reflect.TypeOf(0), func(src any) (any, error) {
+    return 2.5, nil // float64 results are not truncated
+  })
+  registry.Register(pointType, reflect.TypeOf(label("")), func(src any) (any, error) {
+    return "x", nil // same underlying type
+  })
+
+  _, found, err := registry.Convert(registryTestPoint{}, reflect.TypeOf(""))
+  assert.True(t, found)
+  if assert.NotNil(t, err) {
+    assert.True(t, err.Has(ErrorConvertorCustomFailed))
+  }
+
+  _, err = Int(registryTestPoint{}, ConvertOptions{Converters: registry})
+  if assert.NotNil(t, err) {
+    assert.True(t, err.Has(ErrorConvertorCustomFailed))
+  }
+
+  res, found, err := registry.Convert(registryTestPoint{}, reflect.TypeOf(label("")))
+  assert.True(t, found)
+  assert.Nil(t, err)
+  assert.Equal(t, label("x"), res)
+}
+
+func TestUnit_ConverterRegistryScoped(t *testing.T) {
+  registry := NewConverterRegistry()
+  registry.Register(reflect.TypeOf(""), reflect.TypeOf(registryTestPoint{}), func(src any) (any, error) {
+    coords, err := SliceInt(strings.Split(src.(string), ","))
+    if err != nil {
+      return nil, err
+    }
+    return registryTestPoint{X: coords[0], Y: coords[1]}, nil
+  })
+
+  dst := struct {
+    Point registryTestPoint `json:"point"`
+  }{}
+  config := DefaultParserConfig
+  config.Converters = registry
+  err := ToStruct(&dst, config, map[string]any{"point": "3,4"})
+  assert.Nil(t, err)
+  assert.Equal(t, registryTestPoint{X: 3, Y: 4}, dst.Point)
+
+  // the scoped converter is not visible to the default parser, the field is left untouched
+  err = ToStruct(&dst, map[string]any{"point": "5,6"})
+  assert.Nil(t, err)
+  assert.Equal(t, registryTestPoint{X: 3, Y: 4}, dst.Point)
+
+  bytesRegistry := NewConverterRegistry()
+  bytesRegistry.Register(reflect.TypeOf(registryTestPoint{}), reflect.TypeOf([]byte{}), func(src any) (any, error) {
+    point := src.(registryTestPoint)
+    return []byte{byte(point.X), byte(point.Y)}, nil
+  })
+  res, err := SliceByte(registryTestPoint{X: 3, Y: 4}, ConvertOptions{Converters: bytesRegistry})
+  assert.Nil(t, err)
+  assert.Equal(t, []byte{3, 4}, res)
+
+  _, err = SliceByte(registryTestPoint{X: 3, Y: 4})
+  assert.NotNil(t, err, "the scoped converter is not used without the options")
+}
+
+func TestUnit_DeepMergeRegisteredConverter(t *testing.T) {
+  pointType := reflect.TypeOf(registryTestPoint{})
+  mapType := reflect.TypeOf(map[string]any{})
+  RegisterConverter(pointType, mapType, func(src any) (any, error) {
+    point := src.(registryTestPoint)
+    return map[string]any{"x": point.X, "y": point.Y}, nil
+  })
+  t.Cleanup(func() {
+    UnregisterConverter(pointType, mapType)
+  })
+
+  res, err := DeepMerge(map[string]any{"x": 1, "z": 3}, registryTestPoint{X: 10, Y: 20})
+  assert.Nil(t, err)
+  assert.Equal(t, map[string]any{"x": 10, "y": 20, "z": 3}, res)
+}
@@ -0,0 +1,177 @@
+package zgen
+
+import (
+  "strconv"
+  "strings"
+  "time"
+)
+
+// relative time expressions accepted by Time when ConvertOptions.RelativeTime is set
+//
+//	anchors:  now, today, yesterday, tomorrow, start of <unit>, end of <unit> (unit: minute, hour, day, week, month, year)
+//	offsets:  +N<unit> or -N<unit> (unit: ms, s, m, h, d, w, M for months, y), an expression starting with an offset is relative to now
+//	rounding: /<unit> rounds down to the start of the unit (same units as the offsets)
+//
+// examples: "now-15m", "-7d", "today+8h", "now/d", "now-1M/M", "start of month", "end of week"
+// the time is read from ConvertOptions.Clock (time.Now if not set) in ConvertOptions.Location (if set), the weeks start on Monday
+
+// relativeAnchors - named anchors, the anchor function receives the current time
+var relativeAnchors = []struct {
+  name   string
+  anchor func(now time.Time) time.Time
+}{
+  {name: "now", anchor: func(now time.Time) time.Time { return now }},
+  {name: "today", anchor: func(now time.Time) time.Time { return truncateTime(now, "d") }},
+  {name: "yesterday", anchor: func(now time.Time) time.Time { return truncateTime(now, "d").AddDate(0, 0, -1) }},
+  {name: "tomorrow", anchor: func(now time.Time) time.Time { return truncateTime(now, "d").AddDate(0, 0, 1) }},
+}
+
+// relativeUnitNames - units accepted by the start of / end of anchors
+var relativeUnitNames = map[string]string{
+  "minute": "m",
+  "hour":   "h",
+  "day":    "d",
+  "week":   "w",
+  "month":  "M",
+  "year":   "y",
+}
+
+// parseRelativeTime - parses a relative time expression, ok is false if the string is not a valid expression
+func parseRelativeTime(timeStr string, opt ConvertOptions) (dst time.Time, ok bool) {
+  now := time.Now()
+  if opt.Clock != nil {
+    now = opt.Clock()
+  }
+  if opt.Location != nil {
+    now = now.In(opt.Location)
+  }
+
+  expression := strings.TrimSpace(timeStr)
+  lower := strings.ToLower(expression)
+  found := false
+  for _, anchor := range relativeAnchors {
+    if strings.HasPrefix(lower, anchor.name) {
+      dst, expression, found = anchor.anchor(now), expression[len(anchor.name):], true
+      break
+    }
+  }
+  for _, prefix := range []string{"start of ", "end of "} {
+    if found || !strings.HasPrefix(lower, prefix) {
+      continue
+    }
+    expression = strings.TrimSpace(expression[len(prefix):])
+    nameLen := len(expression) - len(strings.TrimLeft(expression, "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"))
+    unit, exists := relativeUnitNames[strings.TrimSuffix(strings.ToLower(expression[:nameLen]), "s")]
+    if !exists {
+      return time.Time{}, false
+    }
+    dst = truncateTime(now, unit)
+    if prefix == "end of " {
+      dst = addTimeUnit(dst, 1, unit).Add(-time.Nanosecond)
+    }
+    expression = expression[nameLen:]
+    found = true
+  }
+  if !found {
+    if !strings.HasPrefix(expression, "+") && !strings.HasPrefix(expression, "-") {
+      return time.Time{}, false
+    }
+    dst = now
+  }
+
+  for expression = strings.TrimSpace(expression); expression != ""; expression = strings.TrimSpace(expression) {
+    operator := expression[0]
+    if operator != '+' && operator != '-' && operator != '/' {
+      return time.Time{}, false
+    }
+    expression = strings.TrimSpace(expression[1:])
+    digits := len(expression) - len(strings.TrimLeft(expression, "0123456789"))
+    amount := 1
+    if operator == '/' {
+      if digits > 0 {
+        return time.Time{}, false
+      }
+    } else {
+      value, er := strconv.Atoi(expression[:digits])
+      if er != nil {
+        return time.Time{}, false
+      }
+      amount = value
+    }
+    expression = expression[digits:]
+    unitLen := len(expression) - len(strings.TrimLeft(expression, "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"))
+    unit := expression[:unitLen]
+    if !isRelativeUnit(unit) {
+      return time.Time{}, false
+    }
+    expression = expression[unitLen:]
+
+    switch operator {
+    case '+':
+      dst = addTimeUnit(dst, amount, unit)
+    case '-':
+      dst = addTimeUnit(dst, -amount, unit)
+    case '/':
+      dst = truncateTime(dst, unit)
+    }
+  }
+
+  return dst, true
+}
+
+// isRelativeUnit - checks the offset and rounding units
+func isRelativeUnit(unit string) bool {
+  switch unit {
+  case "ms", "s", "m", "h", "d", "w", "M", "y":
+    return true
+  }
+  return false
+}
+
+// addTimeUnit - adds amount units to the time, days, weeks, months and years follow the calendar (wall clock)
+func addTimeUnit(timeVal time.Time, amount int, unit string) time.Time {
+  switch unit {
+  case "ms":
+    return timeVal.Add(time.Duration(amount) * time.Millisecond)
+  case "s":
+    return timeVal.Add(time.Duration(amount) * time.Second)
+  case "m":
+    return timeVal.Add(time.Duration(amount) * time.Minute)
+  case "h":
+    return timeVal.Add(time.Duration(amount) * time.Hour)
+  case "d":
+    return timeVal.AddDate(0, 0, amount)
+  case "w":
+    return timeVal.AddDate(0, 0, 7*amount)
+  case "M":
+    return timeVal.AddDate(0, amount, 0)
+  case "y":
+    return timeVal.AddDate(amount, 0, 0)
+  }
+  return timeVal
+}
+
+// truncateTime - rounds the time down to the start of the unit in its location, weeks start on Monday
+func truncateTime(timeVal time.Time, unit string) time.Time {
+  year, month, day := timeVal.Date()
+  location := timeVal.Location()
+  switch unit {
+  case "ms":
+    return timeVal.Truncate(time.Millisecond)
+  case "s":
+    return time.Date(year, month, day, timeVal.Hour(), timeVal.Minute(), timeVal.Second(), 0, location)
+  case "m":
+    return time.Date(year, month, day, timeVal.Hour(), timeVal.Minute(), 0, 0, location)
+  case "h":
+    return time.Date(year, month, day, timeVal.Hour(), 0, 0, 0, location)
+  case "d":
+    return time.Date(year, month, day, 0, 0, 0, 0, location)
+  case "w":
+    return time.Date(year, month, day-(int(timeVal.Weekday())+6)%7, 0, 0, 0, 0, location)
+  case "M":
+    return time.Date(year, month, 1, 0, 0, 0, 0, location)
+  case "y":
+    return time.Date(year, time.January, 1, 0, 0, 0, 0, location)
+  }
+  return timeVal
+}
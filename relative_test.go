@@ -0,0 +1,97 @@
+package zgen
+
+import (
+  "testing"
+  "time"
+
+  "github.com/stretchr/testify/assert"
+)
+
+func TestUnit_RelativeTime(t *testing.T) {
+  now := time.Date(2023, 3, 15, 14, 35, 20, 500, time.UTC) // Wednesday
+  opt := ConvertOptions{RelativeTime: true, Clock: func() time.Time { return now }}
+
+  tests := []struct {
+    input  string
+    expect time.Time
+  }{
+    {input: "now", expect: now},
+    {input: "NOW", expect: now},
+    {input: "now-15m", expect: now.Add(-15 * time.Minute)},
+    {input: "now+1h30m", expect: time.Time{}},
+    {input: "now + 2h - 30s", expect: now.Add(2*time.Hour - 30*time.Second)},
+    {input: "-7d", expect: time.Date(2023, 3, 8, 14, 35, 20, 500, time.UTC)},
+    {input: "+250ms", expect: now.Add(250 * time.Millisecond)},
+    {input: "now-1M", expect: time.Date(2023, 2, 15, 14, 35, 20, 500, time.UTC)},
+    {input: "now+1y", expect: time.Date(2024, 3, 15, 14, 35, 20, 500, time.UTC)},
+    {input: "now-2w", expect: time.Date(2023, 3, 1, 14, 35, 20, 500, time.UTC)},
+    {input: "today", expect: time.Date(2023, 3, 15, 0, 0, 0, 0, time.UTC)},
+    {input: "today+8h", expect: time.Date(2023, 3, 15, 8, 0, 0, 0, time.UTC)},
+    {input: "yesterday", expect: time.Date(2023, 3, 14, 0, 0, 0, 0, time.UTC)},
+    {input: "tomorrow", expect: time.Date(2023, 3, 16, 0, 0, 0, 0, time.UTC)},
+    {input: "now/d", expect: time.Date(2023, 3, 15, 0, 0, 0, 0, time.UTC)},
+    {input: "now/h", expect: time.Date(2023, 3, 15, 14, 0, 0, 0, time.UTC)},
+    {input: "now/w", expect: time.Date(2023, 3, 13, 0, 0, 0, 0, time.UTC)},
+    {input: "now-1M/M", expect: time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)},
+    {input: "now/y", expect: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
+    {input: "start of month", expect: time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)},
+    {input: "Start of Week", expect: time.Date(2023, 3, 13, 0, 0, 0, 0, time.UTC)},
+    {input: "end of month", expect: time.Date(2023, 3, 31, 23, 59, 59, 999999999, time.UTC)},
+    {input: "end of day", expect: time.Date(2023, 3, 15, 23, 59, 59, 999999999, time.UTC)},
+    {input: "start of year+1d", expect: time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)},
+  }
+
+  for _, tt := range tests {
+    t.Run(tt.input, func(t *testing.T) {
+      res, err := Time(tt.input, opt)
+      if tt.expect.IsZero() {
+        assert.NotNil(t, err)
+        return
+      }
+      assert.Nil(t, err)
+      assert.Equal(t, tt.expect, res)
+    })
+  }
+
+  t.Run("invalid expressions", func(t *testing.T) {
+    for _, input := range []string{"now-", "now-5", "now-5x", "now/5d", "nowhere", "start of decade", "-"} {
+      _, err := Time(input, opt)
+      assert.NotNil(t, err, input)
+    }
+  })
+
+  t.Run("opt-in", func(t *testing.T) {
+    _, err := Time("now-15m")
+    assert.NotNil(t, err)
+  })
+
+  t.Run("absolute strings still work", func(t *testing.T) {
+    res, err := Time("2023-01-02T15:04:05Z", opt)
+    assert.Nil(t, err)
+    assert.Equal(t, time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC), res)
+  })
+
+  t.Run("location", func(t *testing.T) {
+    tokyo, err := time.LoadLocation("Asia/Tokyo")
+    if !assert.NoError(t, err) {
+      return
+    }
+    res, err := Time("today", ConvertOptions{RelativeTime: true, Clock: opt.Clock, Location: tokyo})
+    assert.Nil(t, err)
+    assert.Equal(t, time.Date(2023, 3, 15, 0, 0, 0, 0, tokyo), res)
+  })
+
+  t.Run("ToStruct", func(t *testing.T) {
+    dst := struct {
+      From time.Time `json:"from"`
+      To   time.Time `json:"to"`
+    }{}
+    config := DefaultParserConfig
+    config.RelativeTime = true
+    config.Clock = opt.Clock
+    err := ToStruct(&dst, config, map[string]any{"from": "now-1d/d", "to": "now"})
+    assert.Nil(t, err)
+    assert.Equal(t, time.Date(2023, 3, 14, 0, 0, 0, 0, time.UTC), dst.From)
+    assert.Equal(t, now, dst.To)
+  })
+}
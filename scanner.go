@@ -14,6 +14,7 @@ const (
 
 var (
   DefaultParserConfig ParserConfig = ParserConfig{
+    ConvertOptions:  DefaultConvertOptions,  // default conversion settings
     EvaluateMethods: false,                  // ignore methods
     KeepPointers:    true,                   // keep pointer values
     Mode:            ParserModeTagsOnly,     // add only tags to the map
@@ -333,19 +334,13 @@ func ToStruct(destStruct any, args ...any) zerror.Error {
     }
     for i := 0; i < elemVal.NumField(); i++ {
       fieldVal := elemVal.Field(i)
-      fieldType := fieldVal.Type()
       fieldName := elemVal.Type().Field(i).Name
       if fieldVal.IsValid() && fieldVal.CanSet() {
         if value, found := dataMap[fieldName]; found { // search for map fields that match the name of the struct fields
-          if value != nil {
-            val := reflect.ValueOf(value)
-            if fieldType == val.Type() { // map field has the same type as struct field
-              fieldVal.Set(val)
-            } else {
-              err = SetFieldValueByType(currentParseSettings, fieldVal, value)
-              if err != nil {
-                return err
-              }
+          if value != nil { // values of the same type as the field are set directly by SetFieldValueByType
+            err = SetFieldValueByType(currentParseSettings, fieldVal, value)
+            if err != nil {
+              return err
             }
           }
         } else { // fieldName was not found in the map, we will try the tags
@@ -358,15 +353,10 @@ func ToStruct(destStruct any, args ...any) zerror.Error {
                 tagElements := strings.Split(tagKey, ",")   // separate elements in tag
                 tagKey = tagElements[0]                     // this should exist since at this point we ruled out empty tags
                 if value, found := dataMap[tagKey]; found { // we found a value by tag
-                  if value != nil {
-                    val := reflect.ValueOf(value)
-                    if fieldType == val.Type() { // struct field type matches map field type
-                      fieldVal.Set(val)
-                    } else { // we try to convert the type
-                      err = SetFieldValueByType(currentParseSettings, fieldVal, value)
-                      if err != nil {
-                        return err
-                      }
+                  if value != nil { // values of the same type as the field are set directly by SetFieldValueByType
+                    err = SetFieldValueByType(currentParseSettings, fieldVal, value)
+                    if err != nil {
+                      return err
                     }
                   }
                 }
@@ -0,0 +1,214 @@
+package zgen
+
+import (
+  "github.com/znxlc/zerror"
+  "math"
+  "reflect"
+  "strconv"
+  "time"
+
+  "github.com/shopspring/decimal"
+)
+
+// strict mode validations, used by the converters when ConvertOptions.Strict is set
+// each validation checks if the src can be converted to the destination without losing information
+
+// strictPrecisionLossError - returns the precision loss error for the conversion
+func strictPrecisionLossError(src any, dstType string) zerror.Error {
+  return zerror.New(ErrorConvertorPrecisionLoss, map[string]any{
+    "from_type": reflect.TypeOf(src).String(),
+    "to_type":   dstType,
+    "value":     src,
+  })
+}
+
+// strictInvalidSyntaxError - returns the invalid syntax error for the conversion
+func strictInvalidSyntaxError(src any, dstType string, er error) zerror.Error {
+  details := map[string]any{
+    "src":      src,
+    "src_type": reflect.TypeOf(src).String(),
+    "dst_type": dstType,
+  }
+  if er != nil {
+    details["error"] = er.Error()
+  }
+  return zerror.New(ErrorConvertorInvalidSyntax, details)
+}
+
+// hasFraction - returns true if the float has a fractional part
+func hasFraction(val float64) bool {
+  if math.IsNaN(val) || math.IsInf(val, 0) {
+    return false // handled by the overflow checks of each converter
+  }
+  return val != math.Trunc(val)
+}
+
+// strictInteger - validates that src can be converted to an integer without losing information
+//  rejects fractional numbers, complex numbers with an imaginary part, times with sub-second precision and strings that do not parse
+func strictInteger(src any, dstType string) zerror.Error {
+  switch val := src.(type) {
+  case float32:
+    if hasFraction(float64(val)) {
+      return strictPrecisionLossError(src, dstType)
+    }
+  case float64:
+    if hasFraction(val) {
+      return strictPrecisionLossError(src, dstType)
+    }
+  case complex64:
+    if imag(val) != 0 || hasFraction(float64(real(val))) {
+      return strictPrecisionLossError(src, dstType)
+    }
+  case complex128:
+    if imag(val) != 0 || hasFraction(real(val)) {
+      return strictPrecisionLossError(src, dstType)
+    }
+  case time.Time:
+    if val.Nanosecond() != 0 {
+      return strictPrecisionLossError(src, dstType)
+    }
+  case decimal.Decimal:
+    if !val.IsInteger() {
+      return strictPrecisionLossError(src, dstType)
+    }
+  case []byte:
+    return strictInteger(string(val), dstType)
+  case string:
+    if _, ok := parseIntegerLiteral(val); ok {
+      return nil
+    }
+    floatVal, er := strconv.ParseFloat(val, 64)
+    if er != nil {
+      return strictInvalidSyntaxError(src, dstType, er)
+    }
+    if hasFraction(floatVal) {
+      return strictPrecisionLossError(src, dstType)
+    }
+  }
+  return nil
+}
+
+// strictFloat - validates that src can be converted to a float with bitSize precision (32 or 64) without losing information
+//  rejects integers above the exact float range, float64 values that are not representable as float32,
+//  complex numbers with an imaginary part, times with sub-second precision and strings that do not parse
+func strictFloat(src any, dstType string, bitSize int) zerror.Error {
+  maxExact := uint64(1 << 53)
+  if bitSize == 32 {
+    maxExact = uint64(1 << 24)
+  }
+  switch val := src.(type) {
+  case int, int8, int16, int32, int64:
+    intVal := reflect.ValueOf(val).Int()
+    if intVal > int64(maxExact) || intVal < -int64(maxExact) {
+      return strictPrecisionLossError(src, dstType)
+    }
+  case uint, uint8, uint16, uint32, uint64:
+    if reflect.ValueOf(val).Uint() > maxExact {
+      return strictPrecisionLossError(src, dstType)
+    }
+  case time.Duration:
+    if int64(val) > int64(maxExact) || int64(val) < -int64(maxExact) {
+      return strictPrecisionLossError(src, dstType)
+    }
+  case float64:
+    if bitSize == 32 && !math.IsNaN(val) && float64(float32(val)) != val {
+      return strictPrecisionLossError(src, dstType)
+    }
+  case complex64:
+    if imag(val) != 0 {
+      return strictPrecisionLossError(src, dstType)
+    }
+  case complex128:
+    if imag(val) != 0 || (bitSize == 32 && !math.IsNaN(real(val)) && float64(float32(real(val))) != real(val)) {
+      return strictPrecisionLossError(src, dstType)
+    }
+  case time.Time:
+    if val.Nanosecond() != 0 {
+      return strictPrecisionLossError(src, dstType)
+    }
+  case []byte:
+    return strictFloat(string(val), dstType, bitSize)
+  case string:
+    if _, er := strconv.ParseFloat(val, bitSize); er != nil {
+      return strictInvalidSyntaxError(src, dstType, er)
+    }
+  }
+  return nil
+}
+
+// strictComplex - validates that src can be converted to a complex number with bitSize precision (64 or 128) without losing information
+func strictComplex(src any, dstType string, bitSize int) zerror.Error {
+  switch val := src.(type) {
+  case complex128:
+    if bitSize == 64 && (float64(float32(real(val))) != real(val) || float64(float32(imag(val))) != imag(val)) {
+      return strictPrecisionLossError(src, dstType)
+    }
+  case []byte:
+    return strictComplex(string(val), dstType, bitSize)
+  case string:
+    if _, er := strconv.ParseComplex(val, bitSize); er != nil {
+      return strictInvalidSyntaxError(src, dstType, er)
+    }
+  default:
+    return strictFloat(src, dstType, bitSize/2)
+  }
+  return nil
+}
+
+// strictDecimal - validates that src can be converted to a decimal without losing information
+func strictDecimal(src any, dstType string) zerror.Error {
+  switch val := src.(type) {
+  case float32:
+    if math.IsNaN(float64(val)) || math.IsInf(float64(val), 0) {
+      return strictPrecisionLossError(src, dstType)
+    }
+  case float64:
+    if math.IsNaN(val) || math.IsInf(val, 0) {
+      return strictPrecisionLossError(src, dstType)
+    }
+  case complex64:
+    if imag(val) != 0 {
+      return strictPrecisionLossError(src, dstType)
+    }
+  case complex128:
+    if imag(val) != 0 {
+      return strictPrecisionLossError(src, dstType)
+    }
+  case time.Time:
+    if val.Nanosecond() != 0 {
+      return strictPrecisionLossError(src, dstType)
+    }
+  }
+  return nil
+}
+
+// strictBool - validates that src represents a bool, numbers must be 0 or 1 (strings are validated by boolWordValue)
+func strictBool(src any, dstType string) zerror.Error {
+  switch val := src.(type) {
+  case int, int8, int16, int32, int64, time.Duration: // integers are compared directly, float64 rounds them above 2^53
+    if intVal := reflect.ValueOf(val).Int(); intVal != 0 && intVal != 1 {
+      return strictPrecisionLossError(src, dstType)
+    }
+  case uint, uint8, uint16, uint32, uint64:
+    if uintVal := reflect.ValueOf(val).Uint(); uintVal != 0 && uintVal != 1 {
+      return strictPrecisionLossError(src, dstType)
+    }
+  case float32, float64:
+    floatVal, err := Float64(val)
+    if err != nil {
+      return err
+    }
+    if floatVal != 0 && floatVal != 1 {
+      return strictPrecisionLossError(src, dstType)
+    }
+  case complex64, complex128:
+    complexVal, err := Complex128(val)
+    if err != nil {
+      return err
+    }
+    if complexVal != 0 && complexVal != 1 {
+      return strictPrecisionLossError(src, dstType)
+    }
+  }
+  return nil
+}
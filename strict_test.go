@@ -0,0 +1,107 @@
+package zgen
+
+import (
+  "math"
+  "testing"
+  "time"
+
+  "github.com/stretchr/testify/assert"
+)
+
+func TestUnit_StrictMode(t *testing.T) {
+  strict := ConvertOptions{Strict: true}
+
+  tests := []struct {
+    name      string
+    convert   func() (any, error)
+    expect    any
+    errorCode string
+  }{
+    // integer destinations
+    {name: "int from integral float", convert: func() (any, error) { return Int(3.0, strict) }, expect: 3},
+    {name: "int from fractional float", convert: func() (any, error) { return Int(3.9, strict) }, errorCode: ErrorConvertorPrecisionLoss},
+    {name: "int8 from fractional float32", convert: func() (any, error) { return Int8(float32(1.5), strict) }, errorCode: ErrorConvertorPrecisionLoss},
+    {name: "int from complex with imaginary part", convert: func() (any, error) { return Int(complex(1, 2), strict) }, errorCode: ErrorConvertorPrecisionLoss},
+    {name: "int from string", convert: func() (any, error) { return Int("12", strict) }, expect: 12},
+    {name: "int from fractional string", convert: func() (any, error) { return Int("12.5", strict) }, errorCode: ErrorConvertorPrecisionLoss},
+    {name: "int from invalid string", convert: func() (any, error) { return Int("banana", strict) }, errorCode: ErrorConvertorInvalidSyntax},
+    {name: "uint16 from invalid []byte", convert: func() (any, error) { return Uint16([]byte("12a"), strict) }, errorCode: ErrorConvertorInvalidSyntax},
+    {name: "int64 from time with nanoseconds", convert: func() (any, error) { return Int64(time.Unix(10, 5), strict) }, errorCode: ErrorConvertorPrecisionLoss},
+    {name: "int64 from uint64 overflow", convert: func() (any, error) { return Int64(uint64(math.MaxUint64), strict) }, errorCode: ErrorConvertorNumberOverflow},
+
+    // float destinations
+    {name: "float32 from exact float64", convert: func() (any, error) { return Float32(0.5, strict) }, expect: float32(0.5)},
+    {name: "float32 from inexact float64", convert: func() (any, error) { return Float32(0.1, strict) }, errorCode: ErrorConvertorPrecisionLoss},
+    {name: "float32 from large int", convert: func() (any, error) { return Float32(1<<25+1, strict) }, errorCode: ErrorConvertorPrecisionLoss},
+    {name: "float64 from large uint", convert: func() (any, error) { return Float64(uint(1<<60+1), strict) }, errorCode: ErrorConvertorPrecisionLoss},
+    {name: "float64 from complex", convert: func() (any, error) { return Float64(complex(1, 1), strict) }, errorCode: ErrorConvertorPrecisionLoss},
+    {name: "float64 from invalid string", convert: func() (any, error) { return Float64("1,5", strict) }, errorCode: ErrorConvertorInvalidSyntax},
+
+    // complex destinations
+    {name: "complex64 from inexact complex128", convert: func() (any, error) { return Complex64(complex(0.1, 0), strict) }, errorCode: ErrorConvertorPrecisionLoss},
+    {name: "complex128 from invalid string", convert: func() (any, error) { return Complex128("1+", strict) }, errorCode: ErrorConvertorInvalidSyntax},
+
+    // decimal destination
+    {name: "decimal from complex", convert: func() (any, error) { return Decimal(complex(1, 1), strict) }, errorCode: ErrorConvertorPrecisionLoss},
+    {name: "decimal from NaN", convert: func() (any, error) { return Decimal(math.NaN(), strict) }, errorCode: ErrorConvertorPrecisionLoss},
+
+    // bool destination
+    {name: "bool from string", convert: func() (any, error) { return Bool("true", strict) }, expect: true},
+    {name: "bool from invalid string", convert: func() (any, error) { return Bool("banana", strict) }, errorCode: ErrorConvertorInvalidSyntax},
+    {name: "bool from 1", convert: func() (any, error) { return Bool(1, strict) }, expect: true},
+    {name: "bool from 5", convert: func() (any, error) { return Bool(5, strict) }, errorCode: ErrorConvertorPrecisionLoss},
+    {name: "bool from large int64", convert: func() (any, error) { return Bool(int64(1<<60), strict) }, errorCode: ErrorConvertorPrecisionLoss},
+    {name: "bool from large uint64", convert: func() (any, error) { return Bool(uint64(1<<63+1), strict) }, errorCode: ErrorConvertorPrecisionLoss},
+    {name: "bool from uint 1", convert: func() (any, error) { return Bool(uint8(1), strict) }, expect: true},
+    {name: "bool from float 0", convert: func() (any, error) { return Bool(0.0, strict) }, expect: false},
+    {name: "bool from string 5", convert: func() (any, error) { return Bool("5", strict) }, errorCode: ErrorConvertorPrecisionLoss},
+    {name: "bool from empty string", convert: func() (any, error) { return Bool("", strict) }, errorCode: ErrorConvertorInvalidSyntax},
+
+    // slices
+    {name: "slice int from fractional", convert: func() (any, error) { return SliceInt([]any{1, 2.5}, strict) }, errorCode: ErrorConvertorPrecisionLoss},
+    {name: "generic", convert: func() (any, error) { return To[int16]("4.2", strict) }, errorCode: ErrorConvertorPrecisionLoss},
+  }
+
+  for _, tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      res, err := tt.convert()
+      if tt.errorCode != "" {
+        if assert.NotNil(t, err) {
+          assert.True(t, err.(interface{ Has(string) bool }).Has(tt.errorCode), "Expected error code '%s' but got '%s'", tt.errorCode, err.Error())
+        }
+        return
+      }
+      assert.Nil(t, err)
+      assert.Equal(t, tt.expect, res)
+    })
+  }
+
+  t.Run("lenient mode keeps the default behavior", func(t *testing.T) {
+    res, err := Int(3.9)
+    assert.Nil(t, err)
+    assert.Equal(t, 3, res)
+
+    boolRes, err := Bool("5")
+    assert.Nil(t, err)
+    assert.True(t, boolRes)
+  })
+}
+
+func TestUnit_StrictModeToStruct(t *testing.T) {
+  dst := struct {
+    Count int     `json:"count"`
+    Ratio float32 `json:"ratio"`
+  }{}
+
+  config := DefaultParserConfig
+  config.Strict = true
+  err := ToStruct(&dst, config, map[string]any{"count": 3.5})
+  if assert.NotNil(t, err) {
+    assert.True(t, err.Has(ErrorConvertorPrecisionLoss))
+  }
+
+  err = ToStruct(&dst, config, map[string]any{"count": "4", "ratio": 0.25})
+  assert.Nil(t, err)
+  assert.Equal(t, 4, dst.Count)
+  assert.Equal(t, float32(0.25), dst.Ratio)
+}
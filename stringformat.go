@@ -0,0 +1,92 @@
+package zgen
+
+import (
+  "github.com/znxlc/zerror"
+  "strconv"
+  "strings"
+  "time"
+
+  "github.com/shopspring/decimal"
+)
+
+// floatString - formats the float with ConvertOptions.FloatFormat (defaultFormat if not set) and FloatPrecision (shortest if nil)
+func floatString(val float64, defaultFormat byte, bitSize int, opt ConvertOptions) string {
+  format := opt.FloatFormat
+  if format == 0 {
+    format = defaultFormat
+  }
+  precision := -1
+  if opt.FloatPrecision != nil {
+    precision = *opt.FloatPrecision
+  }
+  return strconv.FormatFloat(val, format, precision, bitSize)
+}
+
+// timeString - formats the time with ConvertOptions.TimeLayout (TimeFormatISOSTZ if empty) in the NormalizeLocation if set
+func timeString(val time.Time, opt ConvertOptions) (dst string, err zerror.Error) {
+  layout := TimeFormatISOSTZ
+  if opt.TimeLayout != "" {
+    layout, err = TranslateLayout(opt.TimeLayout, opt.TimeLayoutStyle)
+    if err != nil {
+      return "", err
+    }
+  }
+  if opt.NormalizeLocation != nil {
+    val = val.In(opt.NormalizeLocation)
+  }
+  return val.Format(layout), nil
+}
+
+// durationString - formats the duration with ConvertOptions.DurationStyle
+func durationString(val time.Duration, opt ConvertOptions) string {
+  switch opt.DurationStyle {
+  case DurationISO:
+    return formatISODuration(val)
+  case DurationNumber:
+    if opt.DurationUnit <= time.Nanosecond {
+      return strconv.FormatInt(int64(val), 10)
+    }
+    return decimal.NewFromInt(int64(val)).Div(decimal.NewFromInt(int64(opt.DurationUnit))).String()
+  }
+  return val.String()
+}
+
+// formatISODuration - writes the duration as an ISO-8601 duration with day, hour, minute and second designators ("P1DT2H30M", "-PT0.5S")
+// days are 24 hours, the format read back by parseISODuration
+func formatISODuration(val time.Duration) string {
+  if val == 0 {
+    return "PT0S"
+  }
+  var builder strings.Builder
+  abs := uint64(val) // the magnitude also fits for math.MinInt64
+  if val < 0 {
+    builder.WriteString("-")
+    abs = uint64(-val)
+  }
+  builder.WriteString("P")
+  days := abs / uint64(24*time.Hour)
+  abs %= uint64(24 * time.Hour)
+  if days > 0 {
+    builder.WriteString(strconv.FormatUint(days, 10) + "D")
+  }
+  if abs == 0 {
+    return builder.String()
+  }
+  builder.WriteString("T")
+  hours, minutes := abs/uint64(time.Hour), abs%uint64(time.Hour)/uint64(time.Minute)
+  seconds, nanos := abs%uint64(time.Minute)/uint64(time.Second), abs%uint64(time.Second)
+  if hours > 0 {
+    builder.WriteString(strconv.FormatUint(hours, 10) + "H")
+  }
+  if minutes > 0 {
+    builder.WriteString(strconv.FormatUint(minutes, 10) + "M")
+  }
+  if seconds > 0 || nanos > 0 {
+    builder.WriteString(strconv.FormatUint(seconds, 10))
+    if nanos > 0 {
+      builder.WriteString("." + strings.TrimRight(strconv.FormatUint(nanos+uint64(time.Second), 10)[1:], "0")) // zero padded to 9 digits
+    }
+    builder.WriteString("S")
+  }
+  return builder.String()
+}
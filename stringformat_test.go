@@ -0,0 +1,118 @@
+package zgen
+
+import (
+  "math"
+  "testing"
+  "time"
+
+  "github.com/shopspring/decimal"
+  "github.com/stretchr/testify/assert"
+)
+
+func TestUnit_StringWithOptions(t *testing.T) {
+  moment := time.Date(2023, 11, 14, 22, 13, 20, 0, time.UTC)
+  berlin := time.FixedZone("CET", 3600)
+  var nilPointer *int
+  cents := int32(2)
+  zero, one, two, three := 0, 1, 2, 3
+
+  tests := []struct {
+    name   string
+    input  any
+    opt    ConvertOptions
+    expect string
+  }{
+    {name: "float64 default", input: 1234.5, expect: "1234.5"},
+    {name: "float64 fixed precision", input: 1234.5, opt: ConvertOptions{FloatPrecision: &two}, expect: "1234.50"},
+    {name: "float64 zero decimals", input: 3.7, opt: ConvertOptions{FloatFormat: 'f', FloatPrecision: &zero}, expect: "4"},
+    {name: "float64 exponent", input: 1234.5, opt: ConvertOptions{FloatFormat: 'e', FloatPrecision: &three}, expect: "1.234e+03"},
+    {name: "float64 g", input: 1e21, opt: ConvertOptions{FloatFormat: 'g'}, expect: "1e+21"},
+    {name: "float32 default", input: float32(0.1), expect: "0.10000000149011612"},
+    {name: "float32 g", input: float32(0.1), opt: ConvertOptions{FloatFormat: 'g'}, expect: "0.10000000149011612"},
+    {name: "float32 g precision", input: float32(0.1), opt: ConvertOptions{FloatFormat: 'g', FloatPrecision: &three}, expect: "0.1"},
+    {name: "float32 fixed precision", input: float32(2.5), opt: ConvertOptions{FloatFormat: 'f', FloatPrecision: &one}, expect: "2.5"},
+    {name: "time default", input: moment, expect: moment.Format(TimeFormatISOSTZ)},
+    {name: "time layout", input: moment, opt: ConvertOptions{TimeLayout: time.RFC3339}, expect: "2023-11-14T22:13:20Z"},
+    {name: "time strftime layout", input: moment, opt: ConvertOptions{TimeLayout: "%d/%m/%Y %H:%M", TimeLayoutStyle: LayoutStrftime}, expect: "14/11/2023 22:13"},
+    {name: "time location", input: moment, opt: ConvertOptions{TimeLayout: "15:04 MST", NormalizeLocation: berlin}, expect: "23:13 CET"},
+    {name: "duration default", input: 90 * time.Minute, expect: "1h30m0s"},
+    {name: "duration iso", input: 26*time.Hour + 1500*time.Millisecond, opt: ConvertOptions{DurationStyle: DurationISO}, expect: "P1DT2H1.5S"},
+    {name: "duration iso negative", input: -90 * time.Minute, opt: ConvertOptions{DurationStyle: DurationISO}, expect: "-PT1H30M"},
+    {name: "duration iso zero", input: time.Duration(0), opt: ConvertOptions{DurationStyle: DurationISO}, expect: "PT0S"},
+    {name: "duration number", input: 1500 * time.Millisecond, opt: ConvertOptions{DurationStyle: DurationNumber}, expect: "1500000000"},
+    {name: "duration number seconds", input: 1500 * time.Millisecond, opt: ConvertOptions{DurationStyle: DurationNumber, DurationUnit: time.Second}, expect: "1.5"},
+    {name: "bool default", input: true, expect: "true"},
+    {name: "bool words", input: false, opt: ConvertOptions{BoolWords: &BoolWords{True: []string{"yes"}, False: []string{"no", "off"}}}, expect: "no"},
+    {name: "bool words trimmed", input: true, opt: ConvertOptions{BoolWords: &BoolWords{True: []string{" yes "}}}, expect: "yes"},
+    {name: "bool empty words", input: true, opt: ConvertOptions{BoolWords: &BoolWords{}}, expect: "true"},
+    {name: "nil default", input: nil, expect: ""},
+    {name: "nil string", input: nil, opt: ConvertOptions{NilString: "NULL"}, expect: "NULL"},
+    {name: "nil pointer", input: nilPointer, opt: ConvertOptions{NilString: "<nil>"}, expect: "<nil>"},
+    {name: "other types unchanged", input: int64(-42), opt: ConvertOptions{FloatPrecision: &two}, expect: "-42"},
+    {name: "number format float", input: 1234.5, opt: ConvertOptions{NumberFormat: &NumberFormatEU}, expect: "1.234,5"},
+    {name: "number format int", input: -1234567, opt: ConvertOptions{NumberFormat: &NumberFormatEU}, expect: "-1.234.567"},
+    {name: "number format decimal scale", input: decimal.RequireFromString("1234.5"), opt: ConvertOptions{NumberFormat: &NumberFormatEU, DecimalScale: &cents}, expect: "1.234,50"},
+    {name: "number format keeps strings", input: "1234.5", opt: ConvertOptions{NumberFormat: &NumberFormatEU}, expect: "1234.5"},
+  }
+
+  for _, tt := range tests {
+    t.Run(tt.name, func(t *testing.T) {
+      res, err := StringWithOptions(tt.input, tt.opt)
+      assert.Nil(t, err)
+      assert.Equal(t, tt.expect, res)
+    })
+  }
+
+  t.Run("invalid layout", func(t *testing.T) {
+    _, err := StringWithOptions(moment, ConvertOptions{TimeLayout: "%Q", TimeLayoutStyle: LayoutStrftime})
+    assert.NotNil(t, err)
+  })
+}
+
+func TestUnit_FormatISODuration(t *testing.T) {
+  durations := []time.Duration{
+    time.Nanosecond, 999 * time.Millisecond, 61 * time.Second, 25*time.Hour + time.Minute, -7 * 24 * time.Hour, -36*time.Hour - 250*time.Microsecond,
+  }
+  for _, duration := range durations {
+    res := formatISODuration(duration)
+    parsed, err := parseISODuration(res)
+    assert.Nil(t, err, res)
+    assert.Equal(t, duration, parsed, res)
+  }
+  assert.Equal(t, "P106751DT23H47M16.854775807S", formatISODuration(math.MaxInt64))
+  assert.Equal(t, "-P106751DT23H47M16.854775808S", formatISODuration(math.MinInt64))
+}
+
+func TestUnit_StringOptionsToStruct(t *testing.T) {
+  dst := struct {
+    Ratio   string   `json:"ratio"`
+    Created string   `json:"created"`
+    Timeout string   `json:"timeout"`
+    Active  string   `json:"active"`
+    Values  []string `json:"values"`
+  }{}
+
+  config := DefaultParserConfig
+  two := 2
+  config.FloatPrecision = &two
+  config.TimeLayout = "2006-01-02"
+  config.DurationStyle = DurationISO
+  config.BoolWords = &BoolWords{True: []string{"Y"}, False: []string{"N"}}
+  err := ToStruct(&dst, config, map[string]any{
+    "ratio":   0.126,
+    "created": time.Date(2023, 11, 14, 22, 13, 20, 0, time.UTC),
+    "timeout": 30 * time.Second,
+    "active":  true,
+    "values":  []any{1.0, false},
+  })
+  assert.Nil(t, err)
+  assert.Equal(t, "0.13", dst.Ratio)
+  assert.Equal(t, "2023-11-14", dst.Created)
+  assert.Equal(t, "PT30S", dst.Timeout)
+  assert.Equal(t, "Y", dst.Active)
+  assert.Equal(t, []string{"1.00", "N"}, dst.Values)
+
+  res, err := To[string](false, ConvertOptions{BoolWords: config.BoolWords})
+  assert.Nil(t, err)
+  assert.Equal(t, "N", res)
+}
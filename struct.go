@@ -28,6 +28,7 @@ const (
 )
 
 type ParserConfig struct {
+  ConvertOptions           // conversion settings used when filling fields (parser scoped converters, strict mode, etc.)
   EvaluateMethods bool     `json:"evaluate_methods"` // if true, it will try to determine if the struct is a Valuer or a Scanner for example and return its value instead of diving further
   KeepPointers    bool     `json:"keep_pointers"`    // keeps pointer values intact if true, dereferentiates them otherwise
   Mode            int      `json:"mode"`             // parses names and tags based on config value
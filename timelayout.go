@@ -0,0 +1,112 @@
+package zgen
+
+import (
+  "sync"
+  "time"
+)
+
+// TimeLayoutRegistry - ordered list of layouts tried by Time when parsing strings, the first layout that parses the string wins
+type TimeLayoutRegistry struct {
+  mu      sync.RWMutex
+  layouts []string
+}
+
+// DefaultTimeLayoutRegistry - layouts used by Time, NullTime.Scan and ToStruct when ConvertOptions.TimeLayouts is not set
+var DefaultTimeLayoutRegistry = NewTimeLayoutRegistry(
+  time.RFC822,
+  time.RFC850,
+  time.RFC1123,
+  time.RFC822Z,
+  time.RFC3339,
+  time.RFC1123Z,
+  time.RFC3339Nano,
+  TimeFormatISOTZ,   // ISO datetime format with Z timezone
+  TimeFormatISOSTZ,  // ISO datetime format with spacer timezone
+  TimeFormatISO,     // ISO datetime format with no timezone
+  TimeFormatISODate, // ISO date format
+)
+
+// NewTimeLayoutRegistry - creates a layout registry with the layouts in the order they are sent
+// use it with ConvertOptions.TimeLayouts to replace the default layouts
+func NewTimeLayoutRegistry(layouts ...string) *TimeLayoutRegistry {
+  registry := &TimeLayoutRegistry{}
+  registry.Register(layouts...)
+  return registry
+}
+
+// RegisterTimeLayout - adds layouts at the end of the DefaultTimeLayoutRegistry
+// example:
+//
+//	RegisterTimeLayout("2006/01/02 15:04", "Jan 2, 2006")
+func RegisterTimeLayout(layouts ...string) {
+  DefaultTimeLayoutRegistry.Register(layouts...)
+}
+
+// UnregisterTimeLayout - removes layouts from the DefaultTimeLayoutRegistry
+func UnregisterTimeLayout(layouts ...string) {
+  DefaultTimeLayoutRegistry.Unregister(layouts...)
+}
+
+// Register - adds layouts at the end of the registry, layouts that are already registered keep their position
+func (r *TimeLayoutRegistry) Register(layouts ...string) {
+  r.mu.Lock()
+  defer r.mu.Unlock()
+
+  for _, layout := range layouts {
+    if layout == "" || r.index(layout) >= 0 {
+      continue
+    }
+    r.layouts = append(r.layouts, layout)
+  }
+}
+
+// Prepend - adds layouts at the start of the registry (in the order they are sent), existing layouts are moved
+func (r *TimeLayoutRegistry) Prepend(layouts ...string) {
+  r.mu.Lock()
+  defer r.mu.Unlock()
+
+  prepended := []string{}
+  for _, layout := range layouts {
+    if layout == "" {
+      continue
+    }
+    if idx := r.index(layout); idx >= 0 {
+      r.layouts = append(r.layouts[:idx], r.layouts[idx+1:]...)
+    }
+    prepended = append(prepended, layout)
+  }
+  r.layouts = append(prepended, r.layouts...)
+}
+
+// Unregister - removes layouts from the registry
+func (r *TimeLayoutRegistry) Unregister(layouts ...string) {
+  r.mu.Lock()
+  defer r.mu.Unlock()
+
+  for _, layout := range layouts {
+    if idx := r.index(layout); idx >= 0 {
+      r.layouts = append(r.layouts[:idx], r.layouts[idx+1:]...)
+    }
+  }
+}
+
+// Layouts - returns a copy of the registered layouts in order
+func (r *TimeLayoutRegistry) Layouts() []string {
+  if r == nil {
+    return nil
+  }
+  r.mu.RLock()
+  defer r.mu.RUnlock()
+
+  return append([]string{}, r.layouts...)
+}
+
+// index - returns the position of the layout or -1, the caller must hold the lock
+func (r *TimeLayoutRegistry) index(layout string) int {
+  for idx, existing := range r.layouts {
+    if existing == layout {
+      return idx
+    }
+  }
+  return -1
+}
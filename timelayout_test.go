@@ -0,0 +1,122 @@
+package zgen
+
+import (
+  "testing"
+  "time"
+
+  "github.com/stretchr/testify/assert"
+)
+
+func TestUnit_TimeLayoutRegistry(t *testing.T) {
+  registry := NewTimeLayoutRegistry("2006-01-02", "02/01/2006", "2006-01-02")
+  assert.Equal(t, []string{"2006-01-02", "02/01/2006"}, registry.Layouts())
+
+  registry.Register("Jan 2 2006", "02/01/2006")
+  assert.Equal(t, []string{"2006-01-02", "02/01/2006", "Jan 2 2006"}, registry.Layouts())
+
+  registry.Prepend("Jan 2 2006", "15:04")
+  assert.Equal(t, []string{"Jan 2 2006", "15:04", "2006-01-02", "02/01/2006"}, registry.Layouts())
+
+  registry.Unregister("15:04", "missing")
+  assert.Equal(t, []string{"Jan 2 2006", "2006-01-02", "02/01/2006"}, registry.Layouts())
+
+  layouts := registry.Layouts()
+  layouts[0] = "changed"
+  assert.Equal(t, "Jan 2 2006", registry.Layouts()[0], "Layouts returns a copy")
+}
+
+func TestUnit_TimeLayouts(t *testing.T) {
+  expect := time.Date(2023, 1, 2, 15, 4, 0, 0, time.UTC)
+
+  t.Run("dateparse fallback", func(t *testing.T) {
+    res, err := Time("2023/01/02 15:04")
+    assert.Nil(t, err)
+    assert.Equal(t, expect, res)
+
+    res, err = Time("Jan 2, 2023")
+    assert.Nil(t, err)
+    assert.Equal(t, time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC), res)
+  })
+
+  t.Run("dateparse disabled", func(t *testing.T) {
+    _, err := Time("2023/01/02 15:04", ConvertOptions{DisableDateparse: true})
+    if assert.NotNil(t, err) {
+      assert.True(t, err.Has(ErrorConvertorTypeNotSupported))
+    }
+  })
+
+  t.Run("registered layout", func(t *testing.T) {
+    RegisterTimeLayout("02.01.2006 15:04")
+    t.Cleanup(func() {
+      UnregisterTimeLayout("02.01.2006 15:04")
+    })
+
+    res, err := Time("02.01.2023 15:04", ConvertOptions{DisableDateparse: true})
+    assert.Nil(t, err)
+    assert.Equal(t, expect, res)
+  })
+
+  t.Run("layout order", func(t *testing.T) {
+    // 01/02/2023 is ambiguous, the first matching layout wins over dateparse (which reads it as month first)
+    registry := NewTimeLayoutRegistry("02/01/2006")
+    res, err := Time("01/02/2023", ConvertOptions{TimeLayouts: registry})
+    assert.Nil(t, err)
+    assert.Equal(t, time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC), res)
+
+    res, err = Time("01/02/2023")
+    assert.Nil(t, err)
+    assert.Equal(t, time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC), res)
+  })
+
+  t.Run("exact layout", func(t *testing.T) {
+    opt := ConvertOptions{TimeLayout: "2006-01-02 15:04"}
+    res, err := Time("2023-01-02 15:04", opt)
+    assert.Nil(t, err)
+    assert.Equal(t, expect, res)
+
+    _, err = Time("2023-01-02T15:04:00Z", opt)
+    assert.NotNil(t, err, "the exact layout disables the registry and dateparse")
+
+    res, err = Time([]byte("2023-01-02 15:04"), opt)
+    assert.Nil(t, err)
+    assert.Equal(t, expect, res)
+  })
+
+  t.Run("NullTime uses the same parsing", func(t *testing.T) {
+    nullTime := NullTime{}
+    err := nullTime.Scan("2023-01-02T15:04:00Z")
+    assert.Nil(t, err)
+    assert.True(t, nullTime.Valid)
+    assert.Equal(t, expect, nullTime.Time.UTC())
+
+    err = nullTime.Scan([]byte("Jan 2, 2023"))
+    assert.Nil(t, err)
+    assert.True(t, nullTime.Valid)
+    assert.Equal(t, time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC), nullTime.Time)
+
+    err = nullTime.Scan("banana")
+    assert.Nil(t, err)
+    assert.False(t, nullTime.Valid)
+  })
+
+  t.Run("ToStruct", func(t *testing.T) {
+    dst := struct {
+      Created time.Time  `json:"created"`
+      Updated NullTime   `json:"updated"`
+      Deleted *time.Time `json:"deleted"`
+    }{}
+    config := DefaultParserConfig
+    config.TimeLayout = "02.01.2006 15:04"
+    err := ToStruct(&dst, config, map[string]any{"created": "02.01.2023 15:04", "updated": "02.01.2023 15:04", "deleted": "02.01.2023 15:04"})
+    assert.Nil(t, err)
+    assert.Equal(t, expect, dst.Created)
+    assert.True(t, dst.Updated.Valid)
+    assert.Equal(t, expect, dst.Updated.Time)
+    if assert.NotNil(t, dst.Deleted) {
+      assert.Equal(t, expect, *dst.Deleted)
+    }
+
+    err = ToStruct(&dst, config, map[string]any{"created": "2023-01-02"})
+    assert.NotNil(t, err)
+  })
+}
@@ -5,9 +5,10 @@ import (
   "database/sql/driver"
   "encoding/json"
   "errors"
+  "strconv"
   "time"
 
-  "github.com/araddon/dateparse"
+  "github.com/gofrs/uuid"
   "github.com/lib/pq"
 )
 
@@ -177,6 +178,37 @@ func (ns *NullString) MarshalJSON() ([]byte, error) {
   return json.Marshal(ns.String)
 }
 
+// NullUUID - nullable uuid extension
+type NullUUID struct {
+  uuid.NullUUID
+}
+
+// UnmarshalJSON - extension to make element compatible with json.Unmarshal
+func (nu *NullUUID) UnmarshalJSON(data []byte) error {
+  if string(data) == "null" || string(data) == "nil" {
+    nu.Valid = false
+    return nil
+  }
+
+  var temp uuid.UUID
+  if err := json.Unmarshal(data, &temp); err != nil {
+    return err
+  }
+  nu.UUID = temp
+  nu.Valid = true
+
+  return nil
+}
+
+// MarshalJSON - extension to make element compatible with json.Marshal
+func (nu *NullUUID) MarshalJSON() ([]byte, error) {
+  if nu.Valid == false {
+    return json.Marshal(nil)
+  }
+
+  return json.Marshal(nu.UUID)
+}
+
 // NullTime - nullable time extension
 type NullTime struct {
   pq.NullTime
@@ -208,7 +240,7 @@ func (nt *NullTime) MarshalJSON() ([]byte, error) {
   return json.Marshal(nt.Time)
 }
 
-// Scan - Scan override to support parsing from strings
+// Scan - Scan override to support parsing from strings, uses the Time parsing with DefaultConvertOptions (layouts, dateparse, locations)
 func (nt *NullTime) Scan(value interface{}) (err error) {
   if value == nil {
     nt.Valid = false
@@ -221,18 +253,23 @@ func (nt *NullTime) Scan(value interface{}) (err error) {
       nt.Valid = val.Valid
       nt.Time = val.Time
     case time.Time:
-      nt.Time = val
+      nt.Time, _ = Time(val, DefaultConvertOptions)
       nt.Valid = true
     case string:
       nt.Valid = true
-      nt.Time, err = dateparse.ParseAny(val)
+      nt.Time, err = Time(val, DefaultConvertOptions)
       if err != nil {
         nt.Valid = false
       }
+    case []byte:
+      return nt.Scan(string(val))
     case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
       str, _ := String(val)
       nt.Valid = true
-      nt.Time, err = dateparse.ParseAny(str)
+      nt.Time, err = parseTime(str, DefaultConvertOptions)
+      if err == nil && DefaultConvertOptions.NormalizeLocation != nil {
+        nt.Time = nt.Time.In(DefaultConvertOptions.NormalizeLocation)
+      }
       if err != nil {
         nt.Valid = false
       }
@@ -261,3 +298,39 @@ func (sett *DBJSONField) Scan(value any) error {
 
   return json.Unmarshal(b, &sett)
 }
+
+// ByteSize - size in bytes, filled by ToStruct from numbers or human readable strings ("512KiB", "2GB", "1.5 GiB") and written as "1.5GiB" by String
+type ByteSize uint64
+
+// byte size units
+const (
+  Byte ByteSize = 1
+
+  KB ByteSize = 1000 * Byte
+  MB ByteSize = 1000 * KB
+  GB ByteSize = 1000 * MB
+  TB ByteSize = 1000 * GB
+  PB ByteSize = 1000 * TB
+  EB ByteSize = 1000 * PB
+
+  KiB ByteSize = 1 << 10
+  MiB ByteSize = 1 << 20
+  GiB ByteSize = 1 << 30
+  TiB ByteSize = 1 << 40
+  PiB ByteSize = 1 << 50
+  EiB ByteSize = 1 << 60
+)
+
+// String - human readable size using the largest IEC unit that writes the size exactly with at most 2 decimals ("512B", "1.5KiB", "2GiB")
+// sizes without such a unit are written in bytes ("2000000000B"), the result is parsed back to the same size by ToByteSize
+func (b ByteSize) String() string {
+  units := []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB", "EiB"}
+  fractions := []string{"", ".25", ".5", ".75"} // the exact fractions of a binary unit with 2 decimals
+  for unit := len(units) - 1; unit > 0; unit-- {
+    size := ByteSize(1) << (10 * unit)
+    if b >= size && b%(size/4) == 0 {
+      return strconv.FormatUint(uint64(b/size), 10) + fractions[b%size/(size/4)] + units[unit]
+    }
+  }
+  return strconv.FormatUint(uint64(b), 10) + units[0]
+}
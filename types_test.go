@@ -2,6 +2,7 @@ package zgen
 
 import (
   "encoding/json"
+  "math"
   "testing"
   "time"
 
@@ -123,6 +124,38 @@ func TestNullString_UnmarshalJSON(t *testing.T) {
   assert.Equal(t, true, nsVar.Valid)
 }
 
+func TestNullUUID_MarshalJSON(t *testing.T) {
+  nuVar := NullUUID{}
+
+  nuVar.Scan(nil)
+
+  jsonVal, err := nuVar.MarshalJSON()
+  assert.NoError(t, err)
+  assert.Equal(t, "null", string(jsonVal))
+
+  nuVar.Scan("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+
+  jsonVal, err = nuVar.MarshalJSON()
+  assert.NoError(t, err)
+  assert.Equal(t, `"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`, string(jsonVal))
+}
+
+func TestNullUUID_UnmarshalJSON(t *testing.T) {
+  nuVar := NullUUID{}
+
+  err := json.Unmarshal([]byte("null"), &nuVar)
+  assert.NoError(t, err)
+  assert.Equal(t, false, nuVar.Valid)
+
+  err = json.Unmarshal([]byte(`"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`), &nuVar)
+  assert.NoError(t, err)
+  assert.Equal(t, "6ba7b810-9dad-11d1-80b4-00c04fd430c8", nuVar.UUID.String())
+  assert.Equal(t, true, nuVar.Valid)
+
+  err = json.Unmarshal([]byte(`"banana"`), &nuVar)
+  assert.Error(t, err)
+}
+
 func TestNullTime_MarshalJSON(t *testing.T) {
   ntVar := NullTime{}
 
@@ -181,3 +214,37 @@ func TestNullTime_ScanInt(t *testing.T) {
 
   assert.Equal(t, currTime, ntVar.Time.UnixNano())
 }
+
+func TestByteSize_String(t *testing.T) {
+  tests := []struct {
+    input  ByteSize
+    expect string
+  }{
+    {input: 0, expect: "0B"},
+    {input: 512, expect: "512B"},
+    {input: 1023, expect: "1023B"},
+    {input: KiB, expect: "1KiB"},
+    {input: 1536, expect: "1.5KiB"},
+    {input: 2 * GiB, expect: "2GiB"},
+    {input: 2*MiB + 768*KiB, expect: "2.75MiB"},
+    {input: 10 * MB, expect: "10000000B"},
+    {input: 2_000_000_000, expect: "1953125KiB"},
+    {input: 15 * EiB, expect: "15EiB"},
+    {input: ByteSize(math.MaxInt64), expect: "9223372036854775807B"},
+    {input: ByteSize(math.MaxUint64), expect: "18446744073709551615B"},
+  }
+
+  for _, tt := range tests {
+    assert.Equal(t, tt.expect, tt.input.String())
+  }
+
+  for _, size := range []ByteSize{0, 1, 1536, 10 * MB, 2_000_000_000, 3*GiB + 1, EiB, ByteSize(math.MaxInt64), ByteSize(math.MaxUint64)} {
+    res, err := ToByteSize(size.String())
+    assert.Nil(t, err, size.String())
+    assert.Equal(t, size, res, "String must round trip through ToByteSize")
+  }
+
+  str, err := String(3 * MiB)
+  assert.Nil(t, err)
+  assert.Equal(t, "3MiB", str)
+}
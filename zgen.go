@@ -58,6 +58,8 @@ func Clone(src any) any {
 // DeepMerge - returns the merged value between 2 elements
 //
 //	Merge is performed recursively by traversing all the nodes in a map or appending to the existing slices
+//	  Elements of different types are brought to a common type using the converters of DefaultConverterRegistry (see RegisterConverter),
+//	  scoped registries (ConvertOptions.Converters) are not used, DeepMerge does not accept conversion options
 //	  If FlagDeepMergeOverwriteEnabled is set, the merge is performed only on level 1 of the map, any other type will be overwritten
 //	Inputs
 //
@@ -96,6 +98,21 @@ func DeepMerge(element1, element2 interface{}, args ...int) (result interface{},
     }
   }
 
+  // registered converters are used to bring elements of different types to a common type before merging
+  if element1 != nil && element2 != nil && reflect.TypeOf(element1) != reflect.TypeOf(element2) {
+    if converted, found, zer := DefaultConverterRegistry.Convert(element2, reflect.TypeOf(element1)); found {
+      if zer != nil {
+        return nil, zer
+      }
+      element2 = converted
+    } else if converted, found, zer := DefaultConverterRegistry.Convert(element1, reflect.TypeOf(element2)); found {
+      if zer != nil {
+        return nil, zer
+      }
+      element1 = converted
+    }
+  }
+
   elem1 := reflect.ValueOf(element1)
   elem2 := reflect.ValueOf(element2)
 